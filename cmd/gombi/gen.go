@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// The spec function can only be called from within its own package, so gen
+// drives the package with a temporary test file.
+const genTestFile = "gombi_gen_test.go"
+
+func gen(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	spec := fs.String("spec", "", "the spec function returning the matchers")
+	names := fs.String("var", "", "the comma separated variable names of the matchers")
+	output := fs.String("o", "cache.go", "the output file")
+	gotoCode := fs.Bool("goto", false, "write the matchers as goto based code instead of tables")
+	fs.Parse(args)
+	if *spec == "" || *names == "" {
+		fs.Usage()
+		return errors.New("both -spec and -var are required")
+	}
+	vars := strings.Split(*names, ",")
+	out, err := filepath.Abs(*output)
+	if err != nil {
+		return err
+	}
+	pac, err := packageName()
+	if err != nil {
+		return err
+	}
+
+	// the old cache may not compile against the current spec, so it is
+	// replaced by a stub declaring the variables while the matchers are built.
+	old, err := os.ReadFile(out)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.WriteFile(out, stubSource(pac, vars, *gotoCode), 0644); err != nil {
+		return err
+	}
+	if err := runTest(genSource(pac, *spec, vars, out, *gotoCode)); err != nil {
+		restore(out, old)
+		return err
+	}
+	if err := runTest(verifySource(pac, *spec, vars, *gotoCode)); err != nil {
+		restore(out, old)
+		return fmt.Errorf("%s is not equivalent to %s(): %v", *output, *spec, err)
+	}
+	return nil
+}
+
+func restore(file string, old []byte) {
+	if old == nil {
+		os.Remove(file)
+		return
+	}
+	os.WriteFile(file, old, 0644)
+}
+
+func packageName() (string, error) {
+	out, err := exec.Command("go", "list", "-f", "{{.Name}}", ".").Output()
+	if err != nil {
+		return "", fmt.Errorf("go list: %v", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// runTest runs src as the only test of the package in the current directory.
+func runTest(src []byte) error {
+	if err := os.WriteFile(genTestFile, src, 0644); err != nil {
+		return err
+	}
+	defer os.Remove(genTestFile)
+	cmd := exec.Command("go", "test", "-count=1", "-run=^TestGombiGen$", ".")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%v\n%s", err, out)
+	}
+	return nil
+}
+
+func stubSource(pac string, vars []string, gotoCode bool) []byte {
+	var w bytes.Buffer
+	fmt.Fprintf(&w, "package %s\n\n", pac)
+	fmt.Fprintln(&w, `import "h12.io/gombi/scan"`)
+	typ := "*scan.Matcher"
+	if gotoCode {
+		typ = "*scan.GotoMatcher"
+	}
+	fmt.Fprintf(&w, "\nvar %s %s\n", strings.Join(named(vars), ", "), typ)
+	return w.Bytes()
+}
+
+// named returns the variable names without the blank ones, whose matchers are
+// not written.
+func named(vars []string) (names []string) {
+	for _, v := range vars {
+		if v != "_" {
+			names = append(names, v)
+		}
+	}
+	return
+}
+
+func genSource(pac, spec string, vars []string, out string, gotoCode bool) []byte {
+	var w bytes.Buffer
+	writeTestHeader(&w, pac, spec, vars, `"bytes"`, `"os"`)
+	fmt.Fprintln(&w, "\tvar w bytes.Buffer")
+	fmt.Fprintf(&w, "\terr := scan.WriteCache(&w, %q, []scan.CacheVar{\n", pac)
+	for i, v := range vars {
+		if v != "_" {
+			fmt.Fprintf(&w, "\t\t{Name: %q, Matcher: m%d, Goto: %t},\n", v, i, gotoCode)
+		}
+	}
+	fmt.Fprintln(&w, "\t})")
+	fmt.Fprintln(&w, "\tif err != nil {\n\t\tt.Fatal(err)\n\t}")
+	fmt.Fprintf(&w, "\tif err := os.WriteFile(%q, w.Bytes(), 0644); err != nil {\n\t\tt.Fatal(err)\n\t}\n", out)
+	fmt.Fprintln(&w, "}")
+	return w.Bytes()
+}
+
+func verifySource(pac, spec string, vars []string, gotoCode bool) []byte {
+	var w bytes.Buffer
+	writeTestHeader(&w, pac, spec, vars)
+	for i, v := range vars {
+		switch {
+		case v == "_":
+		case gotoCode:
+			fmt.Fprintf(&w, "\tif err := m%d.VerifyGoto(%s); err != nil {\n\t\tt.Fatal(%q, err)\n\t}\n", i, v, v)
+		default:
+			fmt.Fprintf(&w, "\tif !m%d.Equal(%s) {\n\t\tt.Fatal(%q)\n\t}\n", i, v, v+" differs")
+		}
+	}
+	fmt.Fprintln(&w, "}")
+	return w.Bytes()
+}
+
+func writeTestHeader(w *bytes.Buffer, pac, spec string, vars []string, imports ...string) {
+	fmt.Fprintf(w, "package %s\n\nimport (\n", pac)
+	for _, imp := range append(imports, `"testing"`, `"h12.io/gombi/scan"`) {
+		fmt.Fprintf(w, "\t%s\n", imp)
+	}
+	fmt.Fprintln(w, ")\n\nvar _ *scan.Matcher\n\nfunc TestGombiGen(t *testing.T) {")
+	ms := make([]string, len(vars))
+	for i, v := range vars {
+		ms[i] = fmt.Sprintf("m%d", i)
+		if v == "_" {
+			ms[i] = "_"
+		}
+	}
+	fmt.Fprintf(w, "\t%s := %s()\n", strings.Join(ms, ", "), spec)
+}
@@ -0,0 +1,50 @@
+// Command gombi is the tool for packages built on gombi.
+//
+// Usage:
+//
+//	gombi gen -spec name -var names [-o file] [-goto]
+//
+// Gen builds the matchers returned by the spec function of the package in the
+// current directory and writes them into a cache file, so that the scanner of
+// the package can be shipped with precompiled tables. It is meant to be
+// invoked by go generate, e.g.
+//
+//	//go:generate go run h12.io/gombi/cmd/gombi gen -spec spec -var tokCache,errCache
+//
+// The spec function may be unexported, it takes no arguments and returns one
+// or more *scan.Matcher, each of which is written as a variable named by the
+// comma separated list of -var, or skipped if the name is _. After the file is
+// written, the cached matchers are compiled into the package and verified to
+// be equal to freshly built ones.
+//
+// With -goto, the matchers are written as scan.GotoMatcher, whose match
+// function is a state machine of goto statements instead of tables, to be
+// scanned by scan.GotoScanner.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch os.Args[1] {
+	case "gen":
+		err = gen(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gombi:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: gombi gen -spec name -var names [-o file] [-goto]")
+	os.Exit(2)
+}
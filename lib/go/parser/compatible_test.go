@@ -2,12 +2,19 @@ package parser_test
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
+	"go/ast"
 	std "go/parser"
 	"go/printer"
 	"go/token"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"sort"
+	"strings"
 	"testing"
 
 	gom "h12.io/gombi/lib/go/parser"
@@ -37,6 +44,133 @@ func TestCompatible(t *testing.T) {
 	}
 }
 
+var goroot = flag.Bool("goroot", false, "compare with go/parser on all the Go files under $GOROOT/src")
+
+// TestCompatibleCorpus compares the ASTs of Go source files parsed by both
+// parsers, including comments and resolved objects. By default, the packages
+// under $GOROOT/src/go and a few others using most of the syntax, including
+// generics, are compared, only go/ast and go/parser with -short, and all of
+// $GOROOT/src with -goroot.
+func TestCompatibleCorpus(t *testing.T) {
+	src := filepath.Join(runtime.GOROOT(), "src")
+	dirs := []string{".", filepath.Join(src, "go")}
+	for _, dir := range []string{"bytes", "encoding/json", "fmt", "sort", "strconv", "strings", "text/template"} {
+		dirs = append(dirs, filepath.Join(src, dir))
+	}
+	switch {
+	case *goroot:
+		dirs = []string{src}
+	case testing.Short():
+		dirs = []string{".", filepath.Join(src, "go", "ast"), filepath.Join(src, "go", "parser")}
+	}
+	for _, dir := range dirs {
+		filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path != dir && (d.Name() == "testdata" || d.Name() == "vendor" || dir == ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(path, ".go") {
+				t.Run(path, func(t *testing.T) {
+					t.Parallel()
+					compareFile(t, path)
+				})
+			}
+			return nil
+		})
+	}
+}
+
+// compareFile reports the first difference between the AST dumps of a file
+// parsed by both parsers. Files that go/parser fails to parse are skipped.
+func compareFile(t *testing.T, filename string) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdAst, err := std.ParseFile(token.NewFileSet(), filename, src, std.ParseComments)
+	if err != nil {
+		t.Skip(err)
+	}
+	gomAst, err := gom.ParseFile(token.NewFileSet(), filename, src, gom.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, want := fileDump(gomAst), fileDump(stdAst)
+	if got == want {
+		return
+	}
+	gotLines, wantLines := strings.Split(got, "\n"), strings.Split(want, "\n")
+	for i := range wantLines {
+		if i >= len(gotLines) || gotLines[i] != wantLines[i] {
+			from := i - 5
+			if from < 0 {
+				from = 0
+			}
+			to := i + 5
+			if to > len(gotLines) {
+				to = len(gotLines)
+			}
+			t.Errorf("AST differs at line %d of the dump, got\n%s\nexpect\n%s",
+				i+1, strings.Join(gotLines[from:to], "\n"), wantLines[i])
+			return
+		}
+	}
+	t.Errorf("AST dump has %d extra lines", len(gotLines)-len(wantLines))
+}
+
+// fileDump dumps f with the objects of the file scope sorted by name, because
+// maps are dumped in random order.
+func fileDump(f *ast.File) string {
+	var w bytes.Buffer
+	ast.Fprint(&w, nil, f, func(name string, v reflect.Value) bool {
+		return v.Kind() != reflect.Map
+	})
+	if f.Scope == nil {
+		return w.String()
+	}
+	names := make([]string, 0, len(f.Scope.Objects))
+	for name := range f.Scope.Objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&w, "%s %s\n", name, f.Scope.Objects[name].Kind)
+	}
+	return w.String()
+}
+
+func TestCompatibleExpr(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, src := range []string{
+		"a + b * c",
+		"a * b + c",
+		"a - b - c",
+		"a || b && c == d + e * f",
+		"a * b << c | d < e && !f",
+		"f[int]",
+		"f[int, []string](x)",
+		"m[string, T[int],]{}",
+		"[]List[int]{}",
+		"~int | string",
+	} {
+		stdAst, stdErr := std.ParseExpr(src)
+		gomAst, gomErr := gom.ParseExpr(src)
+		expect("parse error", gomErr).Equal(stdErr)
+		expect(src, astDump(gomAst)).Equal(astDump(stdAst))
+	}
+}
+
+func astDump(x ast.Expr) string {
+	var w bytes.Buffer
+	ast.Fprint(&w, nil, x, nil)
+	return w.String()
+}
+
 func printAst(o interface{}, fset *token.FileSet) {
 	printer.Fprint(os.Stdout, fset, o)
 }
@@ -59,6 +59,7 @@ const (
 	Trace                                          // print a trace of parsed productions
 	DeclarationErrors                              // report declaration errors
 	SpuriousErrors                                 // same as AllErrors, for backward-compatibility
+	SkipObjectResolution                           // skip deprecated identifier resolution; see ParseFile
 	AllErrors         = SpuriousErrors             // report all errors (not just the first 10 on different lines)
 )
 
@@ -88,29 +89,42 @@ func ParseFile(fset *token.FileSet, filename string, src interface{}, mode Mode)
 		return nil, err
 	}
 
+	file := fset.AddFile(filename, -1, len(text))
+
 	var p parser
 	defer func() {
 		if e := recover(); e != nil {
-			_ = e.(bailout) // re-panics if it's not a bailout
+			// resume same panic if it's not a bailout
+			bail, ok := e.(bailout)
+			if !ok {
+				panic(e)
+			} else if bail.msg != "" {
+				p.errors.Add(p.file.Position(bail.pos), bail.msg)
+			}
 		}
 
 		// set result values
-		//if f == nil {
-		//	// source is not a valid Go source file - satisfy
-		//	// ParseFile API and return a valid (but) empty
-		//	// *ast.File
-		//	f = &ast.File{
-		//		Name:  new(ast.Ident),
-		//		Scope: ast.NewScope(nil),
-		//	}
-		//}
+		if f == nil {
+			// source is not a valid Go source file - satisfy
+			// ParseFile API and return a valid (but) empty
+			// *ast.File
+			f = &ast.File{
+				Name:  new(ast.Ident),
+				Scope: ast.NewScope(nil),
+			}
+		}
+
+		// Ensure the start/end are consistent,
+		// whether parsing succeeded or not.
+		f.FileStart = token.Pos(file.Base())
+		f.FileEnd = token.Pos(file.Base() + file.Size())
 
 		p.errors.Sort()
 		err = p.errors.Err()
 	}()
 
 	// parse source
-	p.init(fset, filename, text, mode)
+	p.init(file, text, mode)
 	f = p.parseFile()
 
 	return
@@ -178,7 +192,7 @@ func ParseDir(fset *token.FileSet, path string, filter func(os.FileInfo) bool, m
 	for _, d := range list {
 		if strings.HasSuffix(d.Name(), ".go") && (filter == nil || filter(d)) {
 			filename := filepath.Join(path, d.Name())
-			if src, err := StdParseFile(fset, filename, nil, mode); err == nil {
+			if src, err := ParseFile(fset, filename, nil, mode); err == nil {
 				name := src.Name.Name
 				pkg, found := pkgs[name]
 				if !found {
@@ -204,24 +218,9 @@ func ParseDir(fset *token.FileSet, path string, filter func(os.FileInfo) bool, m
 //
 func ParseExpr(x string) (ast.Expr, error) {
 	var p parser
-	p.init(token.NewFileSet(), "", []byte(x), 0)
-
-	// Set up pkg-level scopes to avoid nil-pointer errors.
-	// This is not needed for a correct expression x as the
-	// std_parser will be ok with a nil topScope, but be cautious
-	// in case of an erroneous x.
-	p.openScope()
-	p.pkgScope = p.topScope
+	fset := token.NewFileSet()
+	p.init(fset.AddFile("", -1, len(x)), []byte(x), 0)
 	e := p.parseExprOrType()
-	p.closeScope()
-	assert(p.topScope == nil, "unbalanced scopes")
-
-	//// If a semicolon was inserted, consume it;
-	//// report an error if there's more tokens.
-	//if p.tok == token.SEMICOLON {
-	//	p.next()
-	//}
-	//p.expect(token.EOF)
 
 	if p.errors.Len() > 0 {
 		p.errors.Sort()
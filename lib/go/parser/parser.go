@@ -3,14 +3,19 @@ package parser
 import (
 	"fmt"
 	"go/ast"
+	"go/build/constraint"
 	"go/scanner"
 	"go/token"
+	"sort"
+	"strings"
 
 	"h12.io/gombi/parse"
 )
 
+// The parser scans all the tokens of a source file first, recording the lead
+// and line comments of each token the same way as go/parser does, then parses
+// the tokens with the gombi parser and walks the parse tree to build the AST.
 type parser struct {
-	rule    *parse.R
 	file    *token.File
 	errors  scanner.ErrorList
 	scanner scanner.Scanner
@@ -18,39 +23,44 @@ type parser struct {
 	// Tracing/debugging
 	mode  Mode // parsing mode
 	trace bool // == (mode & Trace != 0)
-	//indent int  // indentation used for tracing output
 
 	// Comments
 	comments    []*ast.CommentGroup
 	leadComment *ast.CommentGroup // last lead comment
 	lineComment *ast.CommentGroup // last line comment
+	top         bool              // in top of file (before package clause)
+	goVersion   string            // minimum Go version found in //go:build comment
 
 	// Next token
 	pos token.Pos   // token position
 	tok token.Token // one token look-ahead
 	lit string      // token literal
+	end token.Pos   // token end
 
-	//// Error recovery
-	//// (used to limit the number of calls to syncXXX functions
-	//// w/o making scanning progress - avoids potential endless
-	//// loops across multiple parser functions during error recovery)
-	//syncPos token.Pos // last synchronization position
-	//syncCnt int       // number of calls to syncXXX without progress
+	tokens  []tokenInfo       // scanned tokens, comments excluded
+	imports []*ast.ImportSpec // list of imports
+}
 
-	//// Non-syntactic parser control
-	//exprLev int  // < 0: in control clause, >= 0: in expression
-	//inRhs   bool // if set, the parser is parsing a rhs expression
+// tokenInfo is a scanned token together with the comments before it.
+type tokenInfo struct {
+	pos      token.Pos
+	tok      token.Token
+	lit      string
+	end      token.Pos
+	lead     *ast.CommentGroup // lead comment of the token
+	line     *ast.CommentGroup // line comment of the previous token
+	comments int               // number of comment groups before the token
+}
 
-	// Ordinary identifier scopes
-	pkgScope   *ast.Scope   // pkgScope.Outer == nil
-	topScope   *ast.Scope   // top-most scope; may be pkgScope
-	unresolved []*ast.Ident // unresolved identifiers
-	//imports    []*ast.ImportSpec // list of imports
+func (p *parser) init(file *token.File, src []byte, mode Mode) {
+	p.file = file
+	eh := func(pos token.Position, msg string) { p.errors.Add(pos, msg) }
+	p.scanner.Init(p.file, src, eh, scanner.ScanComments)
 
-	// Label scopes
-	// (maintained by open/close LabelScope)
-	labelScope  *ast.Scope     // label scope for current function
-	targetStack [][]*ast.Ident // stack of unresolved labels
+	p.top = true
+	p.mode = mode
+	p.trace = mode&Trace != 0 // for convenience (p.trace is used frequently)
+	p.next()
 }
 
 func (p *parser) error(pos token.Pos, msg string) {
@@ -72,28 +82,41 @@ func (p *parser) error(pos token.Pos, msg string) {
 	p.errors.Add(epos, msg)
 }
 
-func (p *parser) errorExpected(pos token.Pos, msg string) {
-	msg = "expected " + msg
-	if pos == p.pos {
-		// the error happened at the current position;
-		// make the error message more specific
-		if p.tok == token.SEMICOLON && p.lit == "\n" {
-			msg += ", found newline"
-		} else {
-			msg += ", found '" + p.tok.String() + "'"
-			if p.tok.IsLiteral() {
-				msg += " " + p.lit
+// ----------------------------------------------------------------------------
+// Scanning
+
+// Advance to the next token.
+func (p *parser) next0() {
+	for {
+		p.pos, p.tok, p.lit = p.scanner.Scan()
+		p.end = p.scanner.End()
+		if p.tok == token.COMMENT {
+			if p.top && strings.HasPrefix(p.lit, "//go:build") {
+				if x, err := constraint.Parse(p.lit); err == nil {
+					p.goVersion = constraint.GoVersion(x)
+				}
+			}
+			if p.mode&ParseComments == 0 {
+				continue
 			}
+		} else {
+			// Found a non-comment; top of file is over.
+			p.top = false
 		}
+		break
 	}
-	p.error(pos, msg)
+}
+
+// lineFor returns the line of pos, ignoring line directive adjustments.
+func (p *parser) lineFor(pos token.Pos) int {
+	return p.file.PositionFor(pos, false).Line
 }
 
 // Consume a comment and return it and the line on which it ends.
 func (p *parser) consumeComment() (comment *ast.Comment, endline int) {
 	// /*-style comments may end on a different line than where they start.
 	// Scan the comment for '\n' chars and adjust endline accordingly.
-	endline = p.file.Line(p.pos)
+	endline = p.lineFor(p.pos)
 	if p.lit[1] == '*' {
 		// don't use range here - no need to decode Unicode code points
 		for i := 0; i < len(p.lit); i++ {
@@ -104,8 +127,7 @@ func (p *parser) consumeComment() (comment *ast.Comment, endline int) {
 	}
 
 	comment = &ast.Comment{Slash: p.pos, Text: p.lit}
-
-	p.pos, p.tok, p.lit = p.scanner.Scan()
+	p.next0()
 
 	return
 }
@@ -114,11 +136,10 @@ func (p *parser) consumeComment() (comment *ast.Comment, endline int) {
 // comments list, and return it together with the line at which
 // the last comment in the group ends. A non-comment token or n
 // empty lines terminate a comment group.
-//
 func (p *parser) consumeCommentGroup(n int) (comments *ast.CommentGroup, endline int) {
 	var list []*ast.Comment
-	endline = p.file.Line(p.pos)
-	for p.tok == token.COMMENT && p.file.Line(p.pos) <= endline+n {
+	endline = p.lineFor(p.pos)
+	for p.tok == token.COMMENT && p.lineFor(p.pos) <= endline+n {
 		var comment *ast.Comment
 		comment, endline = p.consumeComment()
 		list = append(list, comment)
@@ -131,564 +152,599 @@ func (p *parser) consumeCommentGroup(n int) (comments *ast.CommentGroup, endline
 	return
 }
 
-// ----------------------------------------------------------------------------
-// Scoping support
-
-func (p *parser) openScope() {
-	p.topScope = ast.NewScope(p.topScope)
-}
-
-func (p *parser) closeScope() {
-	p.topScope = p.topScope.Outer
-}
-
-func (p *parser) openLabelScope() {
-	p.labelScope = ast.NewScope(p.labelScope)
-	p.targetStack = append(p.targetStack, nil)
-}
+// Advance to the next non-comment token. In the process, collect
+// any comment groups encountered, and remember the last lead and
+// line comments.
+//
+// A lead comment is a comment group that starts and ends in a
+// line without any other tokens and that is followed by a non-comment
+// token on the line immediately after the comment group.
+//
+// A line comment is a comment group that follows a non-comment
+// token on the same line, and that has no tokens after it on the line
+// where it ends.
+//
+// Lead and line comments may be considered documentation that is
+// stored in the AST.
+func (p *parser) next() {
+	p.leadComment = nil
+	p.lineComment = nil
+	prev := p.pos
+	p.next0()
+
+	if p.tok == token.COMMENT {
+		var comment *ast.CommentGroup
+		var endline int
+
+		if p.lineFor(p.pos) == p.lineFor(prev) {
+			// The comment is on same line as the previous token; it
+			// cannot be a lead comment but may be a line comment.
+			comment, endline = p.consumeCommentGroup(0)
+			if p.lineFor(p.pos) != endline || p.tok == token.SEMICOLON || p.tok == token.EOF {
+				// The next token is on a different line, thus
+				// the last comment group is a line comment.
+				p.lineComment = comment
+			}
+		}
 
-func (p *parser) closeLabelScope() {
-	// resolve labels
-	n := len(p.targetStack) - 1
-	scope := p.labelScope
-	for _, ident := range p.targetStack[n] {
-		ident.Obj = scope.Lookup(ident.Name)
-		if ident.Obj == nil && p.mode&DeclarationErrors != 0 {
-			p.error(ident.Pos(), fmt.Sprintf("label %s undefined", ident.Name))
+		// consume successor comments, if any
+		endline = -1
+		for p.tok == token.COMMENT {
+			comment, endline = p.consumeCommentGroup(1)
 		}
-	}
-	// pop label scope
-	p.targetStack = p.targetStack[0:n]
-	p.labelScope = p.labelScope.Outer
-}
-
-func (p *parser) declare(decl, data interface{}, scope *ast.Scope, kind ast.ObjKind, idents ...*ast.Ident) {
-	for _, ident := range idents {
-		assert(ident.Obj == nil, "identifier already declared or resolved")
-		obj := ast.NewObj(kind, ident.Name)
-		// remember the corresponding declaration for redeclaration
-		// errors and global variable resolution/typechecking phase
-		obj.Decl = decl
-		obj.Data = data
-		ident.Obj = obj
-		if ident.Name != "_" {
-			if alt := scope.Insert(obj); alt != nil && p.mode&DeclarationErrors != 0 {
-				prevDecl := ""
-				if pos := alt.Pos(); pos.IsValid() {
-					prevDecl = fmt.Sprintf("\n\tprevious declaration at %s", p.file.Position(pos))
-				}
-				p.error(ident.Pos(), fmt.Sprintf("%s redeclared in this block%s", ident.Name, prevDecl))
-			}
+
+		if endline+1 == p.lineFor(p.pos) {
+			// The next token is following on the line immediately after the
+			// comment group, thus the last comment group is a lead comment.
+			p.leadComment = comment
 		}
 	}
 }
 
-func (p *parser) declareShortVar(decl *ast.AssignStmt, list []ast.Expr) {
-	// Go spec: A short variable declaration may redeclare variables
-	// provided they were originally declared in the same block with
-	// the same type, and at least one of the non-blank variables is new.
-	n := 0 // number of new variables
-	for _, x := range list {
-		if ident, isIdent := x.(*ast.Ident); isIdent {
-			assert(ident.Obj == nil, "identifier already declared or resolved")
-			obj := ast.NewObj(ast.Var, ident.Name)
-			// remember corresponding assignment for other tools
-			obj.Decl = decl
-			ident.Obj = obj
-			if ident.Name != "_" {
-				if alt := p.topScope.Insert(obj); alt != nil {
-					ident.Obj = alt // redeclaration
-				} else {
-					n++ // new declaration
-				}
-			}
-		} else {
-			p.errorExpected(x.Pos(), "identifier on left side of :=")
+// scanTokens scans the rest of the tokens until EOF.
+func (p *parser) scanTokens() {
+	for {
+		p.tokens = append(p.tokens, tokenInfo{
+			pos:      p.pos,
+			tok:      p.tok,
+			lit:      p.lit,
+			end:      p.end,
+			lead:     p.leadComment,
+			line:     p.lineComment,
+			comments: len(p.comments),
+		})
+		if p.tok == token.EOF {
+			break
 		}
-	}
-	if n == 0 && p.mode&DeclarationErrors != 0 {
-		p.error(list[0].Pos(), "no new variables on left side of :=")
+		p.next()
 	}
 }
 
-//// The unresolved object is a sentinel to mark identifiers that have been added
-//// to the list of unresolved identifiers. The sentinel is only used for verifying
-//// internal consistency.
-//var unresolved = new(ast.Object)
-
-// If x is an identifier, tryResolve attempts to resolve x by looking up
-// the object it denotes. If no object is found and collectUnresolved is
-// set, x is marked as unresolved and collected in the list of unresolved
-// identifiers.
-//
-func (p *parser) tryResolve(x ast.Expr, collectUnresolved bool) {
-	// nothing to do if x is not an identifier or the blank identifier
-	ident, _ := x.(*ast.Ident)
-	if ident == nil {
-		return
-	}
-	assert(ident.Obj == nil, "identifier already declared or resolved")
-	if ident.Name == "_" {
-		return
-	}
-	// try to resolve the identifier
-	for s := p.topScope; s != nil; s = s.Outer {
-		if obj := s.Lookup(ident.Name); obj != nil {
-			ident.Obj = obj
-			return
-		}
-	}
-	// all local scopes are known, so any unresolved identifier
-	// must be found either in the file scope, package scope
-	// (perhaps in another file), or universe scope --- collect
-	// them so that they can be resolved later
-	if collectUnresolved {
-		ident.Obj = unresolved
-		p.unresolved = append(p.unresolved, ident)
-	}
+// index returns the index of the first token at or after pos.
+func (p *parser) index(pos token.Pos) int {
+	return sort.Search(len(p.tokens), func(i int) bool {
+		return p.tokens[i].pos >= pos
+	})
 }
 
-func (p *parser) resolve(x ast.Expr) {
-	p.tryResolve(x, true)
+// leadCommentOf returns the lead comment of the first token of n.
+func (p *parser) leadCommentOf(n *parse.Node) *ast.CommentGroup {
+	return p.tokens[p.index(nodePos(n))].lead
 }
 
-func (p *parser) init(fset *token.FileSet, filename string, src []byte, mode Mode) {
-	p.file = fset.AddFile(filename, -1, len(src))
-	var m scanner.Mode
-	if mode&ParseComments != 0 {
-		m = scanner.ScanComments
+// expectSemi returns the comment that is associated with the semicolon after
+// end, if any, like expectSemi of go/parser.
+func (p *parser) expectSemi(end token.Pos) *ast.CommentGroup {
+	i := p.index(end)
+	if t := p.tokens[i]; t.tok == token.SEMICOLON {
+		if t.lit == ";" {
+			// explicit semicolon, use following comments
+			return p.tokens[i+1].line
+		}
+		// artificial semicolon, use preceding comments
+		return t.line
 	}
-	eh := func(pos token.Position, msg string) { p.errors.Add(pos, msg) }
-	p.scanner.Init(p.file, src, eh, m)
-
-	p.mode = mode
-	p.trace = mode&Trace != 0 // for convenience (p.trace is used frequently)
-
-	//p.next()
+	return nil
 }
 
-func (p *parser) parseExprOrType() ast.Expr {
-	pp := parse.New(sourceExpr)
-	for {
-		pos, tok, str := p.scanner.Scan()
-		if r := tokenTable[tok]; r != nil {
-			if !pp.Parse(&parse.Token{ID: int(tok), Value: []byte(str), Pos: int(pos)}, r) {
+// stopAt returns the number of tokens parsed in the mode of PackageClauseOnly
+// or ImportsOnly, the rest of the tokens are replaced by EOF.
+func (p *parser) stopAt() int {
+	n := len(p.tokens)
+	if p.mode&(PackageClauseOnly|ImportsOnly) == 0 || n < 3 ||
+		p.tokens[0].tok != token.PACKAGE || p.tokens[1].tok != token.IDENT || p.tokens[2].tok != token.SEMICOLON {
+		return n
+	}
+	i := 3
+	if p.mode&PackageClauseOnly != 0 {
+		return i
+	}
+	for i < n && p.tokens[i].tok == token.IMPORT {
+		// skip to the semicolon after the import declaration
+		depth := 0
+		for i++; i < n; i++ {
+			switch p.tokens[i].tok {
+			case token.LPAREN:
+				depth++
+			case token.RPAREN:
+				depth--
+			}
+			if depth == 0 && p.tokens[i].tok == token.SEMICOLON || p.tokens[i].tok == token.EOF {
 				break
 			}
 		}
-		if tok == token.EOF {
-			break
+		if i == n || p.tokens[i].tok == token.EOF {
+			return n
 		}
+		i++
 	}
-	if len(pp.Results()) != 1 {
-		p.errors.Add(p.file.Position(0), "gombi parse error")
-		return nil
-	}
-	return p.parseGoExpr(pp.Results()[0])
+	return i
 }
 
-func (p *parser) parseFile() *ast.File {
-	pp := parse.New(sourceFile)
-	skipScan := false
-	for {
-		prev := p.pos
-		if skipScan {
-			skipScan = false
+// parse parses the tokens with the root rule and returns the parse tree, or
+// nil if there is any error.
+func (p *parser) parse(root *parse.R) *parse.Node {
+	p.scanTokens()
+	if p.errors.Len() != 0 {
+		return nil
+	}
+	tokens := p.tokens
+	if n := p.stopAt(); n < len(tokens) {
+		if c := tokens[n].comments; c > 0 {
+			p.comments = p.comments[:c]
 		} else {
-			p.pos, p.tok, p.lit = p.scanner.Scan()
+			p.comments = nil
 		}
-		if r := tokenTable[p.tok]; r != nil {
-			if !pp.Parse(&parse.Token{ID: int(p.tok), Value: []byte(p.lit), Pos: int(p.pos)}, r) {
-				break
-			}
+		tokens = append(tokens[:n:n], tokenInfo{pos: tokens[n].pos, tok: token.EOF})
+	}
+	pp := parse.New(root)
+	for _, t := range tokens {
+		r := tokenTable[t.tok]
+		if r == nil {
+			p.error(t.pos, fmt.Sprintf("unexpected %s", t.tok))
+			return nil
 		}
-		p.leadComment = nil
-		p.lineComment = nil
-		switch p.tok {
-		case token.COMMENT:
-			var comment *ast.CommentGroup
-			var endline int
-
-			if p.file.Line(p.pos) == p.file.Line(prev) {
-				// The comment is on same line as the previous token; it
-				// cannot be a lead comment but may be a line comment.
-				comment, endline = p.consumeCommentGroup(0)
-				if p.file.Line(p.pos) != endline {
-					// The next token is on a different line, thus
-					// the last comment group is a line comment.
-					p.lineComment = comment
-				}
-			}
-
-			// consume successor comments, if any
-			endline = -1
-			for p.tok == token.COMMENT {
-				comment, endline = p.consumeCommentGroup(1)
-			}
-
-			if endline+1 == p.file.Line(p.pos) {
-				// The next token is following on the line immediately after the
-				// comment group, thus the last comment group is a lead comment.
-				p.leadComment = comment
-			}
-			skipScan = true
-
-		case token.EOF:
+		if !pp.Parse(&parse.Token{ID: int(t.tok), Value: []byte(t.lit), Pos: int(t.pos)}, r) {
 			break
 		}
 	}
-	if len(pp.Results()) != 1 {
-		if len(pp.Results()) > 1 {
-			fmt.Println("Ambiguous", len(pp.Results()))
-		} else {
-			fmt.Println("no result")
-		}
-		p.errors.Add(p.file.Position(0), "")
+	if pp.Error() != nil {
+		p.addParseErrors(pp.Errors())
+		return nil
+	}
+	// the tokens end with EOF, which has been parsed without an error, so
+	// there is exactly one result.
+	if p.addAmbiguities(pp.Ambiguities()) {
 		return nil
 	}
-	return p.parseSourceFile(pp.Results()[0])
+	return pp.Results()[0]
 }
 
-func (p *parser) parseSourceFile(n *parse.Node) *ast.File {
-	p.openScope()
-	pac, name := p.parsePackageClause(n.Child(0))
-	impDecls, impSpecs := p.parseImports(n.Child(2).Find(importDecls))
-	decls := append(impDecls, p.parseTopLevelDecls(n.Child(2).Find(topLevelDecls))...)
-	p.closeScope()
-	return &ast.File{
-		Package:  pac,
-		Name:     name,
-		Decls:    decls,
-		Scope:    p.topScope,
-		Imports:  impSpecs,
-		Comments: p.comments,
-	}
-}
-
-func (p *parser) parseTopLevelDecls(n *parse.Node) (decls []ast.Decl) {
-	n.EachItem(func(item *parse.Node) {
-		decls = append(decls, p.parseTopLevelDecl(item.Child(0)))
-	})
-	return
+func (p *parser) parseFile() *ast.File {
+	n := p.parse(sourceFile)
+	if n == nil {
+		return nil
+	}
+	return p.parseSourceFile(n)
 }
 
-func (p *parser) parseTopLevelDecl(n *parse.Node) ast.Decl {
-	n = n.Child(0)
-	switch n.Rule() {
-	case decl:
-		return p.parseDecl(n)
-	case funcDecl:
-		return p.parseFuncDecl(n)
-	case methodDecl:
-		return p.parseMethodDecl(n)
+func (p *parser) parseExprOrType() ast.Expr {
+	n := p.parse(sourceExpr)
+	if n == nil {
+		return nil
 	}
-	return nil
+	return p.parseExpr(n.Child(0))
 }
 
-func (p *parser) parseDecl(n *parse.Node) ast.Decl {
-	n = n.Child(0)
-	switch n.Rule() {
-	case constDecl:
-		return p.parseConstDecl(n)
-	case typeDecl:
-		return p.parseTypeDecl(n)
-	case varDecl:
-		return p.parseVarDecl(n)
+// addAmbiguities reports the ambiguous nodes of a parse result, which are
+// caused by the grammar rather than the source, and returns true if any. An
+// array type or type parameters of a type spec is not reported, it is
+// resolved by arrayOrTypeParams.
+func (p *parser) addAmbiguities(as []parse.Ambiguity) bool {
+	reported := false
+	for _, a := range as {
+		if a.Rule == typeSpec && a.Alternatives == 2 {
+			continue
+		}
+		reported = true
+		pos := token.Pos(0)
+		if a.First != nil {
+			pos = token.Pos(a.First.Pos)
+		}
+		p.error(pos, fmt.Sprintf("ambiguous %s with %d alternatives", a.Rule.Name(), a.Alternatives))
 	}
-	return nil
+	return reported
 }
 
-func (p *parser) parseConstDecl(n *parse.Node) ast.Decl {
-	fmt.Println(n)
-	return nil
+// addParseErrors converts the errors of the gombi parser to Go parser errors.
+func (p *parser) addParseErrors(errs parse.ErrorList) {
+	for _, e := range errs {
+		names := make([]string, len(e.Expected))
+		for i, r := range e.Expected {
+			names[i] = "'" + r.Name() + "'"
+		}
+		msg := "expected " + strings.Join(names, " or ")
+		if e.Term == parse.EOF {
+			msg += ", found 'EOF'"
+		} else if tok := token.Token(e.Token.ID); tok == token.SEMICOLON && string(e.Token.Value) == "\n" {
+			msg += ", found newline"
+		} else {
+			msg += ", found '" + tok.String() + "'"
+			if tok.IsLiteral() {
+				msg += " " + string(e.Token.Value)
+			}
+		}
+		p.error(token.Pos(e.Token.Pos), msg)
+	}
 }
 
-func (p *parser) parseTypeDecl(n *parse.Node) ast.Decl {
-	fmt.Println(n)
-	return nil
+// ----------------------------------------------------------------------------
+// Parse tree helpers
+
+func nodePos(n *parse.Node) token.Pos {
+	return token.Pos(n.Pos())
 }
 
-func (p *parser) parseVarDecl(n *parse.Node) ast.Decl {
-	var specs []ast.Spec
-	lParen, rParen := declListEach(n.Child(1), func(item *parse.Node) {
-		specs = append(specs, p.parseVarSpec(item))
-	})
-	return &ast.GenDecl{
-		TokPos: token.Pos(n.Child(0).Pos()),
-		Tok:    token.Token(n.Child(0).ID()),
-		Lparen: token.Pos(lParen),
-		Specs:  specs,
-		Rparen: token.Pos(rParen),
+// nodeTok returns the token of a terminal node, or the only terminal of a
+// rule of terminal alternatives like assignOp.
+func nodeTok(n *parse.Node) token.Token {
+	for n.ChildCount() == 1 {
+		n = n.Child(0)
 	}
+	return token.Token(n.ID())
 }
 
-func declListEach(n *parse.Node, visit func(*parse.Node)) (lParen, rParen int) {
-	if n.Child(0).Child(0).Is(term("(")) {
-		n.Child(0).Child(1).Each(func(item *parse.Node) {
-			visit(item.Child(0))
-		})
-		if n.Child(0).Child(2) != nil {
-			visit(n.Child(0).Child(2).Child(0))
-		}
-		return n.Child(0).Child(0).Pos(), n.Child(0).Child(3).Pos()
+// eachListItem visits the items of a list defined by mList.
+func eachListItem(n *parse.Node, visit func(*parse.Node)) {
+	if n.ChildCount() == 0 {
+		return
 	}
 	visit(n.Child(0))
-	return 0, 0
+	eachZeroOrMore(n.Child(1), func(item *parse.Node) {
+		visit(item.Child(1))
+	})
 }
 
-func (p *parser) parseValueSpec(n *parse.Node) *ast.ValueSpec {
-	n = n.Child(0)
-	spec := ast.ValueSpec{}
-	if n.Is(identifierList) {
-		spec.Names = p.parseIdentList(n)
-	} else {
-		spec.Names = p.parseIdentList(n.Child(0))
-		if n.Child(1).Is(type_) {
-			spec.Type = p.parseType(n.Child(1))
-		}
-		spec.Values = p.parseExprList(n.LastChild())
+// eachZeroOrMore visits the items of a rule defined by ZeroOrMore.
+func eachZeroOrMore(n *parse.Node, visit func(*parse.Node)) {
+	if n.ChildCount() > 0 {
+		n.Child(0).EachItem(visit)
 	}
-	return &spec
 }
 
-func (p *parser) parseVarSpec(n *parse.Node) *ast.ValueSpec {
-	if n.Is(valueSpec) {
-		return p.parseValueSpec(n)
-	}
-	return &ast.ValueSpec{
-		Names: p.parseIdentList(n.Child(0)),
-		Type:  p.parseType(n.Child(1)),
+// eachInlineListItem visits the items of a list defined by sList.
+func eachInlineListItem(n *parse.Node, visit func(*parse.Node)) {
+	visit(n.Child(0))
+	if n.ChildCount() > 1 {
+		n.Child(1).EachItem(func(item *parse.Node) {
+			visit(item.Child(1))
+		})
 	}
 }
 
-func (p *parser) parseMethodDecl(n *parse.Node) ast.Decl {
-	fmt.Println(n)
-	return nil
-}
-
-func (p *parser) parseLabeledStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	return nil
-}
+// ----------------------------------------------------------------------------
+// Source files
 
-func (p *parser) parseGoStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	return nil
-}
+func (p *parser) parseSourceFile(n *parse.Node) *ast.File {
+	doc := p.leadCommentOf(n)
+	pos, ident := p.parsePackageClause(n.Child(0))
+	if ident.Name == "_" && p.mode&DeclarationErrors != 0 {
+		p.error(p.tokens[2].pos, "invalid package name _")
+	}
+	var decls []ast.Decl
+	eachZeroOrMore(n.Child(2), func(item *parse.Node) {
+		decls = append(decls, p.parseGenDecl(item.Child(0)))
+	})
+	eachZeroOrMore(n.Child(3), func(item *parse.Node) {
+		decls = append(decls, p.parseDecl(item.Child(0)))
+	})
 
-func (p *parser) parseReturnStmt(n *parse.Node) ast.Stmt {
-	ret := ast.ReturnStmt{
-		Return: token.Pos(n.Child(0).Pos()),
+	f := &ast.File{
+		Doc:     doc,
+		Package: pos,
+		Name:    ident,
+		Decls:   decls,
+		// File{Start,End} are set by the defer in the caller.
+		Imports:   p.imports,
+		Comments:  p.comments,
+		GoVersion: p.goVersion,
 	}
-	if n.ChildCount() > 1 {
-		ret.Results = p.parseExprList(n.Child(1))
+	var declErr func(token.Pos, string)
+	if p.mode&DeclarationErrors != 0 {
+		declErr = p.error
 	}
-	return &ret
-}
-
-func (p *parser) parseBreakStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	return nil
-}
-
-func (p *parser) parseContinueStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	return nil
+	if p.mode&SkipObjectResolution == 0 {
+		resolveFile(f, p.file, declErr)
+	}
+	return f
 }
 
-func (p *parser) parseGotoStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	return nil
+func (p *parser) parsePackageClause(n *parse.Node) (token.Pos, *ast.Ident) {
+	return nodePos(n.Child(0)), p.parseIdent(n.Child(1))
 }
 
-func (p *parser) parseFallthroughStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	return nil
-}
+// ----------------------------------------------------------------------------
+// Declarations
 
-func (p *parser) parseSwitchStmt(n *parse.Node) ast.Stmt {
-	p.openScope()
+// parseDecl parses a topLevelDecl or a decl.
+func (p *parser) parseDecl(n *parse.Node) ast.Decl {
 	n = n.Child(0)
 	switch n.Rule() {
-	case exprSwitchStmt:
-		return p.parseExprSwitchStmt(n)
-	case typeSwitchStmt:
-		return p.parseTypeSwitchStmt(n)
+	case decl:
+		return p.parseGenDecl(n.Child(0))
+	case funcDecl:
+		return p.parseFuncDecl(n)
 	}
-	p.closeScope()
-	return nil
-}
-
-func (p *parser) parseExprSwitchStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	// for each case clause/ open/close scope
-	return nil
-}
-
-func (p *parser) parseTypeSwitchStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	// type switch may introduce a variable that needs extra scope
-	// for each case clause/ open/close scope
-	return nil
-}
-
-func (p *parser) parseSelectStmt(n *parse.Node) ast.Stmt {
-	fmt.Println(n)
-	return nil
+	return p.parseGenDecl(n)
 }
 
-func (p *parser) parseFuncDecl(n *parse.Node) ast.Decl {
-	scope := ast.NewScope(p.topScope)
-	funcDecl := ast.FuncDecl{
-		Name: p.parseIdent(n.Child(1)),
+func (p *parser) parseGenDecl(n *parse.Node) *ast.GenDecl {
+	keyword := n.Child(0)
+	d := &ast.GenDecl{
+		Doc:    p.leadCommentOf(keyword),
+		TokPos: nodePos(keyword),
+		Tok:    nodeTok(keyword),
 	}
-	funcOrSig := n.Child(2).Child(0)
-	if funcOrSig.Rule() == signature {
-		funcDecl.Type = p.parseSignature(funcOrSig, scope)
-	} else {
-		funcDecl.Type, funcDecl.Body = p.parseFunc(funcOrSig, scope)
+	list := n.Child(1)
+	if list.ChildCount() == 1 {
+		d.Specs = []ast.Spec{p.parseSpec(list.Child(0), nil)}
+		return d
 	}
-	funcDecl.Type.Func = token.Pos(n.Child(0).Pos())
-	return &funcDecl
+	d.Lparen = nodePos(list.Child(0))
+	d.Rparen = nodePos(list.Child(2))
+	eachListItem(list.Child(1), func(item *parse.Node) {
+		d.Specs = append(d.Specs, p.parseSpec(item, p.leadCommentOf(item)))
+	})
+	return d
 }
 
-func (p *parser) parseFunc(n *parse.Node, scope *ast.Scope) (*ast.FuncType, *ast.BlockStmt) {
-	return p.parseSignature(n.Child(0), scope),
-		p.parseBody(n.Child(1), scope)
+func (p *parser) parseSpec(n *parse.Node, doc *ast.CommentGroup) ast.Spec {
+	switch n.Rule() {
+	case importSpec:
+		return p.parseImportSpec(n, doc)
+	case typeSpec:
+		return p.parseTypeSpec(n, doc)
+	}
+	return p.parseValueSpec(n, doc)
 }
 
-func (p *parser) parseBody(n *parse.Node, scope *ast.Scope) *ast.BlockStmt {
-	p.topScope = scope
-	p.openLabelScope()
-	block := p.parseBlock(n, scope)
-	p.closeLabelScope()
-	p.closeScope()
-	return block
+func (p *parser) parseImportSpec(n *parse.Node, doc *ast.CommentGroup) *ast.ImportSpec {
+	spec := &ast.ImportSpec{Doc: doc}
+	if n.ChildCount() == 2 {
+		if name := n.Child(0); name.Is(identifier) {
+			spec.Name = p.parseIdent(name)
+		} else {
+			spec.Name = &ast.Ident{NamePos: nodePos(name), Name: "."}
+		}
+	}
+	spec.Path = p.parseBasicLit(n.LastChild())
+	if !isValidImport(spec.Path.Value) {
+		p.error(spec.Path.Pos(), "invalid import path: "+spec.Path.Value)
+	}
+	spec.Comment = p.expectSemi(spec.End())
+	p.imports = append(p.imports, spec)
+	return spec
 }
 
-func (p *parser) parseSignature(n *parse.Node, scope *ast.Scope) *ast.FuncType {
-	funcType := ast.FuncType{
-		Params: p.parseParams(n.Child(0), scope),
-	}
-	if n.ChildCount() > 1 {
-		funcType.Results = p.parseResults(n.Child(1), scope)
+// parseValueSpec parses a constSpec or a varSpec.
+func (p *parser) parseValueSpec(n *parse.Node, doc *ast.CommentGroup) *ast.ValueSpec {
+	spec := &ast.ValueSpec{Doc: doc, Names: p.parseIdentList(n.Child(0))}
+	for i := 1; i < n.ChildCount(); i++ {
+		switch c := n.Child(i); c.Rule() {
+		case type_:
+			spec.Type = p.parseExpr(c)
+		case exprList:
+			spec.Values = p.parseExprList(c)
+		}
 	}
-	return &funcType
+	spec.Comment = p.expectSemi(spec.End())
+	return spec
 }
 
-func (p *parser) parseParams(n *parse.Node, scope *ast.Scope) *ast.FieldList {
-	fieldList := ast.FieldList{
-		Opening: token.Pos(n.Child(0).Pos()),
-		Closing: token.Pos(n.LastChild().Pos()),
+func (p *parser) parseTypeSpec(n *parse.Node, doc *ast.CommentGroup) *ast.TypeSpec {
+	if n.Ambiguous() {
+		n = p.arrayOrTypeParams(n)
 	}
-	if n.Child(1).Is(parameterList) {
-		eachListItem(parameterDecl, n.Child(1), func(item *parse.Node) {
-			fieldList.List = append(fieldList.List, p.parseParamDecl(item, scope))
-		})
+	spec := &ast.TypeSpec{Doc: doc, Name: p.parseIdent(n.Child(0))}
+	i := 1
+	if c := n.Child(i); c.Is(typeParams) {
+		spec.TypeParams = p.parseTypeParams(c)
+		i++
+	}
+	if n.ChildCount() > i+1 {
+		spec.Assign = nodePos(n.Child(i))
 	}
-	return &fieldList
+	spec.Type = p.parseExpr(n.LastChild())
+	spec.Comment = p.expectSemi(spec.End())
+	return spec
 }
 
-func (p *parser) parseParamDecl(n *parse.Node, scope *ast.Scope) *ast.Field {
-	field := ast.Field{}
-	if n.Child(0).Is(type_) {
-		field.Type = p.parseType(n.Child(0))
-	} else {
-		field.Names = p.parseIdentList(n.Child(0))
-		if n.Child(1).Is(type_) {
-			field.Type = p.parseType(n.Child(1))
+// arrayOrTypeParams returns the derivation of a type spec like type T[P *C] int
+// that is both an array type and a generic type. Like go/parser, the array
+// length P *C is split into a type parameter P and its constraint *C only if
+// *C cannot be an ordinary expression.
+func (p *parser) arrayOrTypeParams(n *parse.Node) *parse.Node {
+	var array, generic *parse.Node
+	for _, a := range n.Alternatives() {
+		if a.Child(1).Is(typeParams) {
+			generic = a
 		} else {
-			// TODO ...
-			field.Type = p.parseType(n.Child(2))
+			array = a
 		}
 	}
-	p.declare(field, nil, scope, ast.Var, field.Names...)
-	if field.Type != nil {
-		p.resolve(field.Type)
+	if array == nil || generic == nil {
+		return n
+	}
+	t := array.Child(1)
+	for !t.Is(arrayType) {
+		t = t.Child(0)
+	}
+	if name, typ := extractName(p.parseExpr(t.Child(1)), false); name != nil && typ != nil {
+		return generic
+	}
+	return array
+}
+
+// extractName splits the expression x into (name, expr) if syntactically
+// x can be written as name expr. The split only happens if expr is a type
+// element (per the isTypeElem predicate) or if force is set.
+// If x is just a name, the result is (name, nil). If the split succeeds,
+// the result is (name, expr). Otherwise the result is (nil, x).
+func extractName(x ast.Expr, force bool) (*ast.Ident, ast.Expr) {
+	switch x := x.(type) {
+	case *ast.Ident:
+		return x, nil
+	case *ast.BinaryExpr:
+		switch x.Op {
+		case token.MUL:
+			if name, _ := x.X.(*ast.Ident); name != nil && (force || isTypeElem(x.Y)) {
+				// x = name *x.Y
+				return name, &ast.StarExpr{Star: x.OpPos, X: x.Y}
+			}
+		case token.OR:
+			if name, lhs := extractName(x.X, force || isTypeElem(x.Y)); name != nil && lhs != nil {
+				// x = name lhs|x.Y
+				op := *x
+				op.X = lhs
+				return name, &op
+			}
+		}
+	case *ast.CallExpr:
+		if name, _ := x.Fun.(*ast.Ident); name != nil {
+			if len(x.Args) == 1 && x.Ellipsis == token.NoPos && (force || isTypeElem(x.Args[0])) {
+				// x = name (x.Args[0])
+				return name, &ast.ParenExpr{
+					Lparen: x.Lparen,
+					X:      x.Args[0],
+					Rparen: x.Rparen,
+				}
+			}
+		}
 	}
-	return &field
+	return nil, x
 }
 
-func eachInlineListItem(n *parse.Node, visit func(*parse.Node)) {
-	visit(n.Child(0))
-	if n.ChildCount() > 1 {
-		n.Child(1).EachItem(func(item *parse.Node) {
-			visit(item.Child(1))
-		})
+// isTypeElem reports whether x is a (possibly parenthesized) type element expression.
+// The result is false if x could be a type element OR an ordinary (value) expression.
+func isTypeElem(x ast.Expr) bool {
+	switch x := x.(type) {
+	case *ast.ArrayType, *ast.StructType, *ast.FuncType, *ast.InterfaceType, *ast.MapType, *ast.ChanType:
+		return true
+	case *ast.BinaryExpr:
+		return isTypeElem(x.X) || isTypeElem(x.Y)
+	case *ast.UnaryExpr:
+		return x.Op == token.TILDE
+	case *ast.ParenExpr:
+		return isTypeElem(x.X)
 	}
-	return
+	return false
 }
 
-func (p *parser) parseIdentList(n *parse.Node) (idents []*ast.Ident) {
-	eachInlineListItem(n, func(item *parse.Node) {
-		idents = append(idents, p.parseIdent(item))
-	})
-	return
+func (p *parser) parseFuncDecl(n *parse.Node) *ast.FuncDecl {
+	keyword := n.Child(0)
+	d := &ast.FuncDecl{Doc: p.leadCommentOf(keyword)}
+	i := 1
+	if c := n.Child(i); c.Is(parameters) {
+		d.Recv = p.parseParameters(c)
+		i++
+	}
+	d.Name = p.parseIdent(n.Child(i))
+	i++
+	var tparams *ast.FieldList
+	if c := n.Child(i); c.Is(typeParams) {
+		tparams = p.parseTypeParams(c)
+		i++
+	}
+	d.Type = p.parseSignature(n.Child(i), nodePos(keyword))
+	d.Type.TypeParams = tparams
+	if n.ChildCount() > i+1 {
+		d.Body = p.parseBlock(n.Child(i + 1))
+	}
+	return d
 }
 
-func (p *parser) parseResults(n *parse.Node, scope *ast.Scope) *ast.FieldList {
-	n = n.Child(0)
-	switch n.Rule() {
-	case parameters:
-		return p.parseParams(n, scope)
-	case type_:
-		return &ast.FieldList{List: []*ast.Field{{Type: p.parseType(n)}}}
+// ----------------------------------------------------------------------------
+// Blocks and statements
+
+func (p *parser) parseBlock(n *parse.Node) *ast.BlockStmt {
+	return &ast.BlockStmt{
+		Lbrace: nodePos(n.Child(0)),
+		List:   p.parseStmtList(n.Child(1)),
+		Rbrace: nodePos(n.Child(2)),
 	}
-	return nil
 }
 
-func (p *parser) parseBlockStmt(n *parse.Node) *ast.BlockStmt {
-	p.openScope()
-	block := p.parseBlock(n, p.topScope)
-	p.closeScope()
-	return block
+func (p *parser) parseStmtList(n *parse.Node) (list []ast.Stmt) {
+	eachZeroOrMore(n.Child(0), func(item *parse.Node) {
+		list = append(list, p.parseStmtItem(item))
+	})
+	if last := n.Child(1); last.ChildCount() > 0 {
+		list = append(list, p.parseStmtLast(last.Child(0)))
+	}
+	return
 }
 
-func eachListItem(itemRule *parse.R, n *parse.Node, visit func(*parse.Node)) {
-	if n.Child(0).Is(itemRule) {
-		visit(n.Child(0))
-		return
+func (p *parser) parseStmtItem(n *parse.Node) ast.Stmt {
+	switch n.ChildCount() {
+	case 1:
+		semi := n.Child(0)
+		return &ast.EmptyStmt{Semicolon: nodePos(semi), Implicit: string(semi.Value()) == "\n"}
+	case 2:
+		return p.parseStmt(n.Child(0))
+	}
+	return &ast.LabeledStmt{
+		Label: p.parseIdent(n.Child(0)),
+		Colon: nodePos(n.Child(1)),
+		Stmt:  p.parseStmtItem(n.Child(2)),
 	}
-	n.Child(0).EachItem(func(item *parse.Node) {
-		visit(item.Child(0))
-	})
-	visit(n.Child(1))
 }
 
-func (p *parser) parseBlock(n *parse.Node, scope *ast.Scope) *ast.BlockStmt {
-	block := ast.BlockStmt{
-		Lbrace: token.Pos(n.Child(0).Pos()),
-		Rbrace: token.Pos(n.LastChild().Pos()),
+func (p *parser) parseStmtLast(n *parse.Node) ast.Stmt {
+	switch n.ChildCount() {
+	case 1:
+		return p.parseStmt(n.Child(0))
+	case 2:
+		// a label before the closing "}" labels an implicit empty statement
+		colon := nodePos(n.Child(1))
+		return &ast.LabeledStmt{
+			Label: p.parseIdent(n.Child(0)),
+			Colon: colon,
+			Stmt:  &ast.EmptyStmt{Semicolon: p.tokens[p.index(colon)+1].pos, Implicit: true},
+		}
+	}
+	return &ast.LabeledStmt{
+		Label: p.parseIdent(n.Child(0)),
+		Colon: nodePos(n.Child(1)),
+		Stmt:  p.parseStmtLast(n.Child(2)),
 	}
-	eachListItem(stmt, n.Child(1), func(item *parse.Node) {
-		block.List = append(block.List, p.parseStmt(item))
-	})
-	return &block
 }
 
 func (p *parser) parseStmt(n *parse.Node) ast.Stmt {
 	n = n.Child(0)
 	switch n.Rule() {
 	case decl:
-		return p.parseDeclStmt(n)
-	case labeledStmt:
-		return p.parseLabeledStmt(n)
+		return &ast.DeclStmt{Decl: p.parseGenDecl(n.Child(0))}
 	case simpleStmt:
 		return p.parseSimpleStmt(n)
 	case goStmt:
-		return p.parseGoStmt(n)
+		return &ast.GoStmt{Go: nodePos(n), Call: p.parseExpr(n.Child(1)).(*ast.CallExpr)}
+	case deferStmt:
+		return &ast.DeferStmt{Defer: nodePos(n), Call: p.parseExpr(n.Child(1)).(*ast.CallExpr)}
 	case returnStmt:
-		return p.parseReturnStmt(n)
-	case breakStmt:
-		return p.parseBreakStmt(n)
-	case continueStmt:
-		return p.parseContinueStmt(n)
-	case gotoStmt:
-		return p.parseGotoStmt(n)
-	case fallthroughStmt:
-		return p.parseFallthroughStmt(n)
+		s := &ast.ReturnStmt{Return: nodePos(n)}
+		if n.ChildCount() == 2 {
+			s.Results = p.parseExprList(n.Child(1))
+		}
+		return s
+	case breakStmt, continueStmt, gotoStmt, fallthroughStmt:
+		pos := nodePos(n)
+		s := &ast.BranchStmt{TokPos: pos, Tok: p.tokens[p.index(pos)].tok}
+		if n.ChildCount() == 2 {
+			s.Label = p.parseIdent(n.Child(1))
+		}
+		return s
 	case block:
-		return p.parseBlockStmt(n)
+		return p.parseBlock(n)
 	case ifStmt:
 		return p.parseIfStmt(n)
 	case switchStmt:
@@ -697,550 +753,654 @@ func (p *parser) parseStmt(n *parse.Node) ast.Stmt {
 		return p.parseSelectStmt(n)
 	case forStmt:
 		return p.parseForStmt(n)
-	case deferStmt:
-		return p.parseDeferStmt(n)
 	}
-	return nil
+	panic("unexpected statement " + n.Rule().Name())
 }
 
-func (p *parser) parseDeclStmt(n *parse.Node) ast.Stmt {
-	return &ast.DeclStmt{p.parseDecl(n)}
-}
-
-func (p *parser) parseIfStmt(n *parse.Node) ast.Stmt {
-	p.openScope()
-	ifStmt := ast.IfStmt{
-		If: token.Pos(n.Child(0).Pos()),
+// parseSimpleStmt parses a simpleStmt or a headerSimpleStmt.
+func (p *parser) parseSimpleStmt(n *parse.Node) ast.Stmt {
+	switch n.ChildCount() {
+	case 1:
+		return &ast.ExprStmt{X: p.parseExpr(n.Child(0))}
+	case 2:
+		op := n.Child(1)
+		return &ast.IncDecStmt{X: p.parseExpr(n.Child(0)), TokPos: nodePos(op), Tok: nodeTok(op)}
+	}
+	if op := n.Child(1); op.Is(assignOp) {
+		return &ast.AssignStmt{
+			Lhs:    p.parseExprList(n.Child(0)),
+			TokPos: nodePos(op),
+			Tok:    nodeTok(op),
+			Rhs:    p.parseExprList(n.Child(2)),
+		}
 	}
-	ifStmt.Init, ifStmt.Cond = p.parseInitCond(n.Child(1))
-	if blockElse := n.Child(2).Child(0); blockElse.Is(block) {
-		ifStmt.Body = p.parseBlock(blockElse, p.topScope)
-	} else {
-		ifStmt.Body = p.parseBlock(blockElse.Child(1).Child(0), p.topScope)
-		ifStmt.Else = p.parseElse(blockElse.Child(1).Child(2))
+	return &ast.SendStmt{
+		Chan:  p.parseExpr(n.Child(0)),
+		Arrow: nodePos(n.Child(1)),
+		Value: p.parseExpr(n.Child(2)),
 	}
-	p.closeScope()
-	return &ifStmt
 }
 
-func (p *parser) parseInitCond(n *parse.Node) (ast.Stmt, ast.Expr) {
-	n = n.Child(0)
-	if n.Is(expr) {
-		return nil, p.parseCond(n)
-	} else {
-		return p.parseInit(n.Child(0)), p.parseCond(n.Child(2))
+func (p *parser) parseIfStmt(n *parse.Node) *ast.IfStmt {
+	s := &ast.IfStmt{If: nodePos(n)}
+	h := n.Child(1)
+	switch h.ChildCount() {
+	case 1:
+		s.Cond = p.parseExpr(h.Child(0))
+	case 2:
+		s.Cond = p.parseExpr(h.Child(1))
+	case 3:
+		s.Init = p.parseSimpleStmt(h.Child(0))
+		s.Cond = p.parseExpr(h.Child(2))
 	}
+	s.Body = p.parseBlock(n.Child(2))
+	if n.ChildCount() == 5 {
+		if e := n.Child(4); e.Is(ifStmt) {
+			s.Else = p.parseIfStmt(e)
+		} else {
+			s.Else = p.parseBlock(e)
+		}
+	}
+	return s
 }
 
-func (p *parser) parseElse(n *parse.Node) ast.Stmt {
-	if n == nil {
-		return nil
+func (p *parser) parseSwitchStmt(n *parse.Node) ast.Stmt {
+	pos := nodePos(n)
+	var init ast.Stmt
+	var guard *parse.Node
+	if h := n.Child(1); h.Is(switchHeader) {
+		for i := 0; i < h.ChildCount(); i++ {
+			switch c := h.Child(i); c.Rule() {
+			case headerSimpleStmt:
+				init = p.parseSimpleStmt(c)
+			case switchGuard:
+				guard = c.Child(0)
+			}
+		}
 	}
-	n = n.Child(0)
-	switch n.Rule() {
-	case ifStmt:
-		return p.parseIfStmt(n)
-	case block:
-		return p.parseBlock(n, p.topScope)
+	lbrace := n.Child(n.ChildCount() - 3)
+	body := &ast.BlockStmt{Lbrace: nodePos(lbrace), Rbrace: nodePos(n.LastChild())}
+	eachZeroOrMore(n.Child(n.ChildCount()-2), func(c *parse.Node) {
+		body.List = append(body.List, p.parseCaseClause(c))
+	})
+	if guard != nil && guard.Is(typeSwitchGuard) {
+		return &ast.TypeSwitchStmt{Switch: pos, Init: init, Assign: p.parseTypeSwitchGuard(guard), Body: body}
 	}
-	return nil
+	s := &ast.SwitchStmt{Switch: pos, Init: init, Body: body}
+	if guard != nil {
+		s.Tag = p.parseExpr(guard)
+	}
+	return s
 }
 
-func (p *parser) parseForStmt(n *parse.Node) (r ast.Stmt) {
-	p.openScope()
-	forPos := token.Pos(n.Child(0).Pos())
-	n = n.Child(1)
-	if n.Is(block) {
-		return &ast.ForStmt{
-			For:  forPos,
-			Body: p.parseBlock(n, p.topScope),
-		}
+func (p *parser) parseTypeSwitchGuard(n *parse.Node) ast.Stmt {
+	c := n.ChildCount()
+	x := &ast.TypeAssertExpr{
+		X:      p.parseExpr(n.Child(c - 5)),
+		Lparen: nodePos(n.Child(c - 3)),
+		Rparen: nodePos(n.Child(c - 1)),
 	}
-	option := n.Child(0).Child(0)
-	body := p.parseBlock(n.Child(1), p.topScope)
-	switch option.Rule() {
-	case condition:
-		fmt.Println(option)
-	case forClause:
-		fmt.Println(option)
-	case rangeClause:
-		forStmt := p.parseRangeStmt(option)
-		forStmt.For, forStmt.Body = forPos, body
-		r = forStmt
+	if c == 5 {
+		return &ast.ExprStmt{X: x}
 	}
-	p.closeScope()
-	return
-}
-func (p *parser) parseInit(n *parse.Node) ast.Stmt {
-	if n == nil {
-		return nil
+	return &ast.AssignStmt{
+		Lhs:    []ast.Expr{p.parseIdent(n.Child(0))},
+		TokPos: nodePos(n.Child(1)),
+		Tok:    token.DEFINE,
+		Rhs:    []ast.Expr{x},
 	}
-	n = n.Child(0)
-	fmt.Println(n)
-	return nil
-}
-func (p *parser) parseCond(n *parse.Node) ast.Expr {
-	return p.parseExpr(n)
 }
 
-func (p *parser) parsePost(n *parse.Node) ast.Stmt {
-	if n == nil {
-		return nil
+func (p *parser) parseCaseClause(n *parse.Node) *ast.CaseClause {
+	c := &ast.CaseClause{Case: nodePos(n)}
+	if n.ChildCount() == 4 {
+		c.List = p.parseExprList(n.Child(1))
 	}
-	n = n.Child(0)
-	if !n.Is(forClause) {
-		return nil
-	}
-	fmt.Println(n)
-	return nil
+	c.Colon = nodePos(n.Child(n.ChildCount() - 2))
+	c.Body = p.parseStmtList(n.LastChild())
+	return c
 }
 
-func (p *parser) parseDeferStmt(n *parse.Node) (r ast.Stmt) {
-	return &ast.DeferStmt{
-		Defer: token.Pos(n.Child(0).Pos()),
-		Call:  p.parseCallExpr(n.Child(1)),
+func (p *parser) parseSelectStmt(n *parse.Node) *ast.SelectStmt {
+	body := &ast.BlockStmt{Lbrace: nodePos(n.Child(1)), Rbrace: nodePos(n.Child(3))}
+	eachZeroOrMore(n.Child(2), func(c *parse.Node) {
+		body.List = append(body.List, p.parseCommClause(c))
+	})
+	return &ast.SelectStmt{Select: nodePos(n), Body: body}
+}
+
+func (p *parser) parseCommClause(n *parse.Node) *ast.CommClause {
+	c := &ast.CommClause{Case: nodePos(n), Colon: nodePos(n.Child(1)), Body: p.parseStmtList(n.Child(2))}
+	comm := n.Child(0)
+	switch comm.ChildCount() {
+	case 2:
+		c.Comm = &ast.ExprStmt{X: p.parseExpr(comm.Child(1))}
+	case 4:
+		if op := comm.Child(2); nodeTok(op) == token.ARROW {
+			c.Comm = &ast.SendStmt{Chan: p.parseExpr(comm.Child(1)), Arrow: nodePos(op), Value: p.parseExpr(comm.Child(3))}
+		} else {
+			c.Comm = &ast.AssignStmt{
+				Lhs:    p.parseExprList(comm.Child(1)),
+				TokPos: nodePos(op),
+				Tok:    nodeTok(op),
+				Rhs:    []ast.Expr{p.parseExpr(comm.Child(3))},
+			}
+		}
 	}
+	return c
 }
 
-func (p *parser) parseRangeStmt(n *parse.Node) *ast.RangeStmt {
-	kv := n.Child(0).Child(0)
-	tok := n.Child(0).Child(1)
-	rangeStmt := &ast.RangeStmt{
-		TokPos: token.Pos(tok.Pos()),
-		Tok:    token.Token(tok.ID()),
-		X:      p.parseExpr(n.Child(2)),
+func (p *parser) parseForStmt(n *parse.Node) ast.Stmt {
+	pos := nodePos(n)
+	body := p.parseBlock(n.LastChild())
+	if n.ChildCount() == 2 {
+		return &ast.ForStmt{For: pos, Body: body}
 	}
-	if kv.Is(exprList) {
-		es := p.parseExprList(kv)
-		rangeStmt.Key = es[0]
-		if len(es) > 1 {
-			rangeStmt.Value = es[1]
+	switch h := n.Child(1); h.Rule() {
+	case forClause:
+		s := &ast.ForStmt{For: pos, Body: body}
+		if c := h.Child(0); c.ChildCount() > 0 {
+			s.Init = p.parseSimpleStmt(c.Child(0))
 		}
-	} else {
-		es := p.parseIdentList(kv)
-		rangeStmt.Key = es[0]
-		if len(es) > 1 {
-			rangeStmt.Value = es[1]
+		if c := h.Child(2); c.ChildCount() > 0 {
+			s.Cond = p.parseExpr(c.Child(0))
+		}
+		if c := h.Child(4); c.ChildCount() > 0 {
+			s.Post = p.parseSimpleStmt(c.Child(0))
 		}
+		return s
+	case rangeClause:
+		s := &ast.RangeStmt{For: pos, Body: body}
+		if h.ChildCount() == 4 {
+			lhs := p.parseExprList(h.Child(0))
+			s.Key = lhs[0]
+			if len(lhs) > 1 {
+				s.Value = lhs[1]
+			}
+			s.TokPos = nodePos(h.Child(1))
+			s.Tok = nodeTok(h.Child(1))
+		}
+		s.Range = nodePos(h.Child(h.ChildCount() - 2))
+		s.X = p.parseExpr(h.LastChild())
+		return s
+	default:
+		return &ast.ForStmt{For: pos, Cond: p.parseExpr(h), Body: body}
 	}
-	return rangeStmt
-}
-
-func (p *parser) parseSimpleStmt(n *parse.Node) ast.Stmt {
-	n = n.Child(0)
-	switch n.Rule() {
-	case exprStmt:
-		return p.parseExprStmt(n)
-	case sendStmt:
-	case incDecStmt:
-	case assignment:
-		return p.parseAsignment(n)
-	case shortVarDecl:
-		return p.parseShortVarDecl(n)
-	}
-	fmt.Println("simpleStmt", n)
-	return nil
-}
-
-func (p *parser) parseExprStmt(n *parse.Node) ast.Stmt {
-	return &ast.ExprStmt{p.parseExpr(n)}
 }
 
-func (p *parser) parseAsignment(n *parse.Node) ast.Stmt {
-	return &ast.AssignStmt{
-		Lhs: p.parseExprList(n.Child(0)),
-		Tok: token.Token(n.Child(1).Child(0).ID()),
-		Rhs: p.parseExprList(n.Child(2)),
-	}
-}
+// ----------------------------------------------------------------------------
+// Expressions and types
 
-func (p *parser) parseShortVarDecl(n *parse.Node) ast.Stmt {
-	idents := p.parseIdentList(n.Child(0))
-	exprs := make([]ast.Expr, len(idents))
-	for i := range exprs {
-		exprs[i] = idents[i]
-	}
-	ast := &ast.AssignStmt{
-		Lhs:    exprs,
-		TokPos: token.Pos(n.Child(1).Pos()),
-		Tok:    token.Token(n.Child(1).ID()),
-		Rhs:    p.parseExprList(n.Child(2)),
+func (p *parser) parseIdent(n *parse.Node) *ast.Ident {
+	return &ast.Ident{
+		NamePos: nodePos(n),
+		Name:    string(n.Value()),
 	}
-	p.declareShortVar(ast, exprs)
-	return ast
 }
 
-func (p *parser) parseExprList(n *parse.Node) (exprs []ast.Expr) {
-	if n == nil {
-		return
-	}
+func (p *parser) parseIdentList(n *parse.Node) (idents []*ast.Ident) {
 	eachInlineListItem(n, func(item *parse.Node) {
-		exprs = append(exprs, p.parseExpr(item))
+		idents = append(idents, p.parseIdent(item))
 	})
 	return
 }
 
-func (p *parser) parsePackageClause(n *parse.Node) (token.Pos, *ast.Ident) {
-	pac, name := n.Child(0), n.Child(1)
-	return token.Pos(pac.Pos()), &ast.Ident{
-		NamePos: token.Pos(name.Pos()),
-		Name:    string(name.Value()),
-	}
-}
-
-func (p *parser) parseImports(n *parse.Node) (decls []ast.Decl, specs []*ast.ImportSpec) {
-	n.EachItem(func(item *parse.Node) {
-		decl, ss := p.parseImportDecl(item.Child(0))
-		decls = append(decls, decl)
-		specs = append(specs, ss...)
+func (p *parser) parseExprList(n *parse.Node) (exprs []ast.Expr) {
+	eachInlineListItem(n, func(item *parse.Node) {
+		exprs = append(exprs, p.parseExpr(item))
 	})
 	return
 }
 
-func (p *parser) parseImportDecl(n *parse.Node) (decl *ast.GenDecl, specs []*ast.ImportSpec) {
-	decl = &ast.GenDecl{
-		TokPos: token.Pos(n.Child(0).Pos()),
-		Tok:    token.IMPORT,
-	}
-	n = n.Child(1)
-	if n.Is(importSpec) {
-		spec := p.parseImportSpec(n)
-		specs = append(specs, spec)
-		decl.Specs = append(decl.Specs, spec)
-		return
-	}
-	decl.Lparen = token.Pos(n.Child(0).Pos())
-	decl.Rparen = token.Pos(n.LastChild().Pos())
-	if n.ChildCount() == 3 {
-		eachListItem(importSpec, n.Child(1), func(item *parse.Node) {
-			spec := p.parseImportSpec(item)
-			specs = append(specs, spec)
-			decl.Specs = append(decl.Specs, spec)
-		})
-	}
-	return
-}
-
-func (p *parser) parseImportSpec(n *parse.Node) *ast.ImportSpec {
-	spec := ast.ImportSpec{}
-	if n.Child(0).Is(importPath) {
-		spec.Path = p.parseBasicLit(n.Child(0))
-	} else {
-		name := n.Child(0)
-		switch name.Rule() {
-		case identifier:
-			spec.Name = p.parseIdent(name)
-		case term("."):
-			spec.Name = &ast.Ident{
-				NamePos: token.Pos(name.Pos()),
-				Name:    ".",
-			}
-		}
-		spec.Path = p.parseBasicLit(n.Child(1))
-
-	}
-	return &spec
-}
-
-func (p *parser) parseIdent(n *parse.Node) *ast.Ident {
-	return &ast.Ident{
-		NamePos: token.Pos(n.Pos()),
-		Name:    string(n.Value()),
-	}
-}
-
 func (p *parser) parseBasicLit(n *parse.Node) *ast.BasicLit {
+	pos := nodePos(n)
 	return &ast.BasicLit{
-		ValuePos: token.Pos(n.Pos()),
+		ValuePos: pos,
+		ValueEnd: p.tokens[p.index(pos)].end,
 		Kind:     token.Token(n.ID()),
 		Value:    string(n.Value()),
 	}
 }
 
-func (p *parser) parseGoExpr(n *parse.Node) ast.Expr {
-	n = n.Child(0).Child(0)
+// parseExpr parses the node of any expression or type rule. A node of a
+// single child that is not handled explicitly is parsed as the child.
+func (p *parser) parseExpr(n *parse.Node) ast.Expr {
 	switch n.Rule() {
-	case expr:
-		return p.parseExpr(n)
-	case type_:
-		return p.parseType(n)
+	case identifier:
+		return p.parseIdent(n)
+	case intLit, floatLit, imaginaryLit, runeLit, stringLit:
+		return p.parseBasicLit(n)
+	case expr, headerExpr:
+		if n.ChildCount() == 3 {
+			op := n.Child(1)
+			return &ast.BinaryExpr{
+				X:     p.parseExpr(n.Child(0)),
+				OpPos: nodePos(op),
+				Op:    nodeTok(op),
+				Y:     p.parseExpr(n.Child(2)),
+			}
+		}
+	case unaryExpr, headerUnaryExpr:
+		if n.ChildCount() == 2 {
+			return p.parseUnaryExpr(n)
+		}
+	case primaryExpr, headerPrimaryExpr, callExpr:
+		if n.ChildCount() > 1 {
+			return p.parsePrimaryExpr(n)
+		}
+	case operand:
+		switch n.ChildCount() {
+		case 2:
+			return p.parseSignature(n.Child(1), nodePos(n))
+		case 3:
+			return p.parseParenExpr(n)
+		}
+	case type_, chanElem:
+		if n.ChildCount() == 3 {
+			return p.parseParenExpr(n)
+		}
+	case literalValue:
+		return p.parseCompositeLit(nil, n)
+	case funcLit:
+		return &ast.FuncLit{
+			Type: p.parseSignature(n.Child(1), nodePos(n)),
+			Body: p.parseBlock(n.Child(2)),
+		}
+	case qualifiedIdent:
+		return &ast.SelectorExpr{X: p.parseIdent(n.Child(0)), Sel: p.parseIdent(n.Child(2))}
+	case genericType:
+		return p.parseIndexExpr(p.parseExpr(n.Child(0)), n.Child(1))
+	case typeElem:
+		if n.ChildCount() == 2 {
+			x := p.parseExpr(n.Child(0))
+			n.Child(1).EachItem(func(item *parse.Node) {
+				x = &ast.BinaryExpr{X: x, OpPos: nodePos(item), Op: token.OR, Y: p.parseExpr(item.Child(1))}
+			})
+			return x
+		}
+	case typeTerm:
+		if n.ChildCount() == 2 {
+			return &ast.UnaryExpr{OpPos: nodePos(n), Op: token.TILDE, X: p.parseExpr(n.Child(1))}
+		}
+	case arrayType:
+		t := &ast.ArrayType{Lbrack: nodePos(n), Elt: p.parseExpr(n.Child(3))}
+		if l := n.Child(1); l.Is(expr) {
+			t.Len = p.parseExpr(l)
+		} else {
+			t.Len = &ast.Ellipsis{Ellipsis: nodePos(l)}
+		}
+		return t
+	case sliceType:
+		return &ast.ArrayType{Lbrack: nodePos(n), Elt: p.parseExpr(n.Child(2))}
+	case structType:
+		return p.parseStructType(n)
+	case pointerType:
+		return &ast.StarExpr{Star: nodePos(n), X: p.parseExpr(n.Child(1))}
+	case funcType:
+		return p.parseSignature(n.Child(1), nodePos(n))
+	case interfaceType:
+		return p.parseInterfaceType(n)
+	case mapType:
+		return &ast.MapType{Map: nodePos(n), Key: p.parseExpr(n.Child(2)), Value: p.parseExpr(n.Child(4))}
+	case chanType:
+		t := &ast.ChanType{Begin: nodePos(n), Dir: ast.SEND | ast.RECV, Value: p.parseExpr(n.LastChild())}
+		if n.ChildCount() == 3 {
+			t.Arrow = nodePos(n.Child(1))
+			t.Dir = ast.SEND
+		}
+		return t
+	case recvChanType:
+		return &ast.ChanType{Begin: nodePos(n), Arrow: nodePos(n), Dir: ast.RECV, Value: p.parseExpr(n.Child(2))}
 	}
-	return nil
+	if n.ChildCount() == 1 {
+		return p.parseExpr(n.Child(0))
+	}
+	panic("unexpected expression " + n.Rule().Name())
 }
 
-func (p *parser) parseExpr(n *parse.Node) ast.Expr {
-	if n == nil {
-		return nil // ??
-	}
-	switch n.ChildCount() {
-	case 1:
-		return p.parseUnaryExpr(n.Child(0))
-	case 3:
-		op := n.Child(1).Child(0).Child(0)
-		return &ast.BinaryExpr{
-			X:     p.parseExpr(n.Child(0)),
-			OpPos: token.Pos(op.Pos()),
-			Op:    token.Token(op.ID()),
-			Y:     p.parseUnaryExpr(n.Child(2)),
-		}
+func (p *parser) parseParenExpr(n *parse.Node) *ast.ParenExpr {
+	return &ast.ParenExpr{
+		Lparen: nodePos(n),
+		X:      p.parseExpr(n.Child(1)),
+		Rparen: nodePos(n.Child(2)),
 	}
-	return nil
 }
 
 func (p *parser) parseUnaryExpr(n *parse.Node) ast.Expr {
-	if n.Child(0).Is(primaryExpr) {
-		return p.parsePrimaryExpr(n.Child(0))
-	}
-	return &ast.UnaryExpr{
-		OpPos: token.Pos(n.Child(0).Child(0).Pos()),
-		Op:    token.Token(n.Child(0).Child(0).ID()),
-		X:     p.parseUnaryExpr(n.Child(1)),
+	op := n.Child(0)
+	pos, tok := nodePos(op), nodeTok(op)
+	x := p.parseExpr(n.Child(1))
+	switch tok {
+	case token.ARROW:
+		// channel type or receive expression
+		if typ, ok := x.(*ast.ChanType); ok {
+			// (<-type)
+
+			// re-associate position info and <-
+			arrow := pos
+			dir := ast.SEND
+			for ok && dir == ast.SEND {
+				if typ.Dir == ast.RECV {
+					// error: (<-type) is (<-(<-chan T))
+					p.error(typ.Arrow, "expected 'chan'")
+				}
+				arrow, typ.Begin, typ.Arrow = typ.Arrow, arrow, arrow
+				dir, typ.Dir = typ.Dir, ast.RECV
+				typ, ok = typ.Value.(*ast.ChanType)
+			}
+			if dir == ast.SEND {
+				p.error(arrow, "expected channel type")
+			}
+			return x
+		}
+	case token.MUL:
+		// pointer type or unary "*" expression
+		return &ast.StarExpr{Star: pos, X: x}
 	}
+	return &ast.UnaryExpr{OpPos: pos, Op: tok, X: x}
 }
 
 func (p *parser) parsePrimaryExpr(n *parse.Node) ast.Expr {
-	if n.Child(0).Is(operand) {
-		return p.parseOperand(n.Child(0))
+	x := p.parseExpr(n.Child(0))
+	if n.ChildCount() == 3 {
+		// composite literal of an instantiated type
+		x = p.parseIndexExpr(x, n.Child(1))
 	}
-	switch n.Child(1).Rule() {
+	last := n.LastChild()
+	switch last.Rule() {
 	case selector:
-		return p.parseSelector(n)
+		return &ast.SelectorExpr{X: x, Sel: p.parseIdent(last.Child(1))}
 	case index:
-		return p.parseIndex(n)
+		return p.parseIndexExpr(x, last)
 	case slice:
+		return p.parseSliceExpr(x, last)
 	case typeAssertion:
+		return &ast.TypeAssertExpr{
+			X:      x,
+			Lparen: nodePos(last.Child(1)),
+			Type:   p.parseExpr(last.Child(2)),
+			Rparen: nodePos(last.Child(3)),
+		}
 	case call:
-		return p.parseCallExpr(n)
+		return p.parseCallExpr(x, last)
+	case literalValue:
+		return p.parseCompositeLit(x, last)
 	}
-	fmt.Println(n)
-	return nil
+	panic("unexpected primary expression " + last.Rule().Name())
 }
 
-func (p *parser) parseIndex(n *parse.Node) ast.Expr {
-	index := n.Child(1)
-	return &ast.IndexExpr{
-		X:      p.parsePrimaryExpr(n.Child(0)),
-		Lbrack: token.Pos(index.Child(0).Pos()),
-		Index:  p.parseExpr(index.Child(1)),
-		Rbrack: token.Pos(index.Child(2).Pos()),
+// parseIndexExpr parses an index or the type arguments of an instantiation,
+// which is an IndexListExpr if there is more than one type argument.
+func (p *parser) parseIndexExpr(x ast.Expr, n *parse.Node) ast.Expr {
+	var args []ast.Expr
+	for i := 1; i < n.ChildCount()-1; i++ {
+		switch c := n.Child(i); c.Rule() {
+		case expr:
+			args = append(args, p.parseExpr(c))
+		case typeList:
+			args = append(args, p.parseExprList(c)...)
+		}
 	}
-}
-
-func (p *parser) parseSelector(n *parse.Node) ast.Expr {
-	return &ast.SelectorExpr{
-		X:   p.parsePrimaryExpr(n.Child(0)),
-		Sel: p.parseIdent(n.Child(1).Child(1)),
+	lbrack, rbrack := nodePos(n), nodePos(n.LastChild())
+	if len(args) == 1 {
+		return &ast.IndexExpr{X: x, Lbrack: lbrack, Index: args[0], Rbrack: rbrack}
 	}
+	return &ast.IndexListExpr{X: x, Lbrack: lbrack, Indices: args, Rbrack: rbrack}
 }
 
-func (p *parser) parseCallExpr(n *parse.Node) *ast.CallExpr {
-	call := n.Child(1)
-	callExpr := ast.CallExpr{
-		Fun:    p.parsePrimaryExpr(n.Child(0)),
-		Lparen: token.Pos(call.Child(0).Pos()),
-		Rparen: token.Pos(call.LastChild().Pos()),
+func (p *parser) parseSliceExpr(x ast.Expr, n *parse.Node) *ast.SliceExpr {
+	var index [3]ast.Expr
+	ncolons := 0
+	for i := 1; i < n.ChildCount()-1; i++ {
+		if c := n.Child(i); c.Is(expr) {
+			index[ncolons] = p.parseExpr(c)
+		} else {
+			ncolons++
+		}
 	}
-	if call.ChildCount() > 2 {
-		callExpr.Args, callExpr.Ellipsis = p.parseArgs(call.Child(1))
+	return &ast.SliceExpr{
+		X:      x,
+		Lbrack: nodePos(n),
+		Low:    index[0],
+		High:   index[1],
+		Max:    index[2],
+		Slice3: ncolons == 2,
+		Rbrack: nodePos(n.LastChild()),
 	}
-	return &callExpr
 }
 
-func (p *parser) parseArgs(n *parse.Node) ([]ast.Expr, token.Pos) {
-	n = n.Child(0)
-	if n.Is(exprList) {
-		return p.parseExprList(n), 0
+func (p *parser) parseCallExpr(fun ast.Expr, n *parse.Node) *ast.CallExpr {
+	c := &ast.CallExpr{Fun: fun, Lparen: nodePos(n), Rparen: nodePos(n.LastChild())}
+	for i := 1; i < n.ChildCount()-1; i++ {
+		if a := n.Child(i); a.Is(exprList) {
+			c.Args = p.parseExprList(a)
+		} else if nodeTok(a) == token.ELLIPSIS {
+			c.Ellipsis = nodePos(a)
+		}
 	}
-	return p.parseExprList(n.Child(0)), token.Pos(n.Child(1).Pos())
+	return c
 }
 
-func (p *parser) parseOperand(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	switch n.Rule() {
-	case literal:
-		return p.parseLiteral(n)
-	case operandName:
-		return p.parseIdent(n)
-	default:
-		return p.parseExpr(n.Child(1))
+func (p *parser) parseCompositeLit(typ ast.Expr, n *parse.Node) *ast.CompositeLit {
+	lit := &ast.CompositeLit{Type: typ, Lbrace: nodePos(n), Rbrace: nodePos(n.LastChild())}
+	if n.ChildCount() > 2 {
+		eachInlineListItem(n.Child(1), func(e *parse.Node) {
+			if e.ChildCount() == 1 {
+				lit.Elts = append(lit.Elts, p.parseExpr(e.Child(0)))
+				return
+			}
+			lit.Elts = append(lit.Elts, &ast.KeyValueExpr{
+				Key:   p.parseExpr(e.Child(0)),
+				Colon: nodePos(e.Child(1)),
+				Value: p.parseExpr(e.Child(2)),
+			})
+		})
 	}
-	return nil
+	return lit
 }
 
-func (p *parser) parseLiteral(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	switch n.Rule() {
-	case basicLit:
-		return p.parseBasicLit(n.Child(0))
-	case compositeLit:
-		return p.parseCompositeLit(n)
-	case funcLit:
-		return p.parseFuncLit(n)
-	}
-	return nil
+func (p *parser) parseStructType(n *parse.Node) *ast.StructType {
+	fields := &ast.FieldList{Opening: nodePos(n.Child(1)), Closing: nodePos(n.Child(3))}
+	eachListItem(n.Child(2), func(item *parse.Node) {
+		fields.List = append(fields.List, p.parseFieldDecl(item))
+	})
+	return &ast.StructType{Struct: nodePos(n), Fields: fields}
 }
 
-func (p *parser) parseCompositeLit(n *parse.Node) ast.Expr {
-	litValue := n.Child(1)
-	return &ast.CompositeLit{
-		Type:   p.parseLiteralType(n.Child(0)),
-		Lbrace: token.Pos(litValue.Child(0).Pos()),
-		Elts:   p.parseLiteralValue(litValue),
-		Rbrace: token.Pos(litValue.LastChild().Pos()),
+func (p *parser) parseFieldDecl(n *parse.Node) *ast.Field {
+	f := &ast.Field{Doc: p.leadCommentOf(n)}
+	if c := n.Child(0); c.Is(identifierList) {
+		f.Names = p.parseIdentList(c)
+		f.Type = p.parseExpr(n.Child(1))
+	} else if c.ChildCount() == 2 {
+		// embedded pointer type
+		f.Type = &ast.StarExpr{Star: nodePos(c), X: p.parseExpr(c.Child(1))}
+	} else {
+		f.Type = p.parseExpr(c.Child(0))
 	}
-}
-
-func (p *parser) parseLiteralType(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	switch n.Rule() {
-	case arrayType:
-	case structType:
-		return p.parseStructType(n)
-	case sliceType:
-		return p.parseSliceType(n)
-	case mapType:
-	case typeName:
-	default:
-		// array
+	if tag := n.LastChild(); tag.Is(stringLit) {
+		f.Tag = p.parseBasicLit(tag)
 	}
-	fmt.Println(n)
-	return nil
+	f.Comment = p.expectSemi(f.End())
+	return f
 }
 
-func (p *parser) parseSliceType(n *parse.Node) ast.Expr {
-	return &ast.ArrayType{
-		Lbrack: token.Pos(n.Child(0).Pos()),
-		Len:    nil,
-		Elt:    p.parseType(n.Child(2)),
-	}
+func (p *parser) parseInterfaceType(n *parse.Node) *ast.InterfaceType {
+	methods := &ast.FieldList{Opening: nodePos(n.Child(1)), Closing: nodePos(n.Child(3))}
+	eachListItem(n.Child(2), func(item *parse.Node) {
+		f := &ast.Field{}
+		// like go/parser, only a method or an element starting with a type
+		// name has a doc comment
+		if p.tokens[p.index(nodePos(item))].tok == token.IDENT {
+			f.Doc = p.leadCommentOf(item)
+		}
+		if item.ChildCount() == 2 {
+			f.Names = []*ast.Ident{p.parseIdent(item.Child(0))}
+			f.Type = p.parseSignature(item.Child(1), token.NoPos)
+		} else {
+			f.Type = p.parseExpr(item.Child(0))
+		}
+		f.Comment = p.expectSemi(f.End())
+		methods.List = append(methods.List, f)
+	})
+	return &ast.InterfaceType{Interface: nodePos(n), Methods: methods}
 }
 
-func (p *parser) parseLiteralValue(n *parse.Node) (exprs []ast.Expr) {
-	if n.ChildCount() == 3 {
-		eachListItem(element, n.Child(1), func(item *parse.Node) {
-			exprs = append(exprs, p.parseElement(item))
-		})
+// parseSignature parses the signature of a function type starting at pos.
+func (p *parser) parseSignature(n *parse.Node, pos token.Pos) *ast.FuncType {
+	t := &ast.FuncType{Func: pos, Params: p.parseParameters(n.Child(0))}
+	if n.ChildCount() == 2 {
+		t.Results = p.parseResult(n.Child(1))
 	}
-	return
+	return t
 }
 
-func (p *parser) parseElement(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	if n.Is(value) {
-		return p.parseValue(n)
+func (p *parser) parseResult(n *parse.Node) *ast.FieldList {
+	if c := n.Child(0); c.Is(parameters) {
+		return p.parseParameters(c)
 	}
-	return p.parseKeyValue(n)
+	return &ast.FieldList{List: []*ast.Field{{Type: p.parseExpr(n.Child(0))}}}
 }
 
-func (p *parser) parseKeyValue(n *parse.Node) *ast.KeyValueExpr {
-	return &ast.KeyValueExpr{
-		Key:   p.parseExpr(n.Child(0)),
-		Colon: token.Pos(n.Child(1).Pos()),
-		Value: p.parseValue(n.Child(2)),
+func (p *parser) parseParameters(n *parse.Node) *ast.FieldList {
+	params := &ast.FieldList{Opening: nodePos(n), Closing: nodePos(n.LastChild())}
+	if n.ChildCount() > 2 {
+		params.List = p.parseParameterList(n.Child(1))
 	}
+	return params
 }
 
-func (p *parser) parseKey(n *parse.Node) ast.Expr {
-	return p.parseExpr(n)
+func (p *parser) parseTypeParams(n *parse.Node) *ast.FieldList {
+	tparams := &ast.FieldList{Opening: nodePos(n), Closing: nodePos(n.LastChild())}
+	eachInlineListItem(n.Child(1), func(item *parse.Node) {
+		tparams.List = append(tparams.List, &ast.Field{
+			Names: p.parseIdentList(item.Child(0)),
+			Type:  p.parseExpr(item.Child(1)),
+		})
+	})
+	return tparams
 }
 
-func (p *parser) parseValue(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	switch n.Rule() {
-	case expr:
-		return p.parseExpr(n)
-	case literalValue:
+// typeNameIdent returns the identifier of a type_ node if the type is an
+// unqualified type name, otherwise nil.
+func typeNameIdent(n *parse.Node) *parse.Node {
+	if n = n.Child(0); n.Is(typeName) {
+		if n = n.Child(0); n.Is(identifier) {
+			return n
+		}
 	}
-	fmt.Println("parseValue", n)
 	return nil
 }
 
-func (p *parser) parseFuncLit(n *parse.Node) ast.Expr {
-	scope := ast.NewScope(p.topScope)
-	funcLit := ast.FuncLit{}
-	funcLit.Type, funcLit.Body = p.parseFunc(n.Child(1), scope)
-	return &funcLit
-}
-
-func (p *parser) parseType(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	switch n.Rule() {
-	case typeName:
-		return p.parseTypeName(n)
-	case typeLit:
-		return p.parseTypeLit(n)
-	}
-	return p.parseType(n.Child(1))
-}
-
-func (p *parser) parseTypeName(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	if n.Rule() == identifier {
-		return p.parseIdent(n)
+// parseParameterList distributes the types to the parameter names and groups
+// the parameters of the same type the same way as go/parser does.
+func (p *parser) parseParameterList(n *parse.Node) (params []*ast.Field) {
+	type field struct {
+		name *ast.Ident
+		typ  ast.Expr
 	}
-	return p.parseQualifiedIdent(n)
-}
+	var list []field
+	var named int // number of parameters that have an explicit name and type
+	var typed int // number of parameters that have an explicit type
+	eachInlineListItem(n, func(item *parse.Node) {
+		var par field
+		switch item.ChildCount() {
+		case 1:
+			if name := typeNameIdent(item.Child(0)); name != nil {
+				par.name = p.parseIdent(name)
+			} else {
+				par.typ = p.parseExpr(item.Child(0))
+			}
+		case 2:
+			if c := item.Child(0); c.Is(identifier) {
+				par.name = p.parseIdent(c)
+				par.typ = p.parseExpr(item.Child(1))
+			} else {
+				par.typ = &ast.Ellipsis{Ellipsis: nodePos(c), Elt: p.parseExpr(item.Child(1))}
+			}
+		case 3:
+			par.name = p.parseIdent(item.Child(0))
+			par.typ = &ast.Ellipsis{Ellipsis: nodePos(item.Child(1)), Elt: p.parseExpr(item.Child(2))}
+		}
+		list = append(list, par)
+		if par.name != nil && par.typ != nil {
+			named++
+		}
+		if par.typ != nil {
+			typed++
+		}
+	})
 
-func (p *parser) parseQualifiedIdent(n *parse.Node) ast.Expr {
-	return &ast.SelectorExpr{
-		X:   p.parseIdent(n.Child(0)),
-		Sel: p.parseIdent(n.Child(2)),
+	// distribute parameter types (len(list) > 0)
+	if named == 0 {
+		// all unnamed => found names are type names
+		for i := range list {
+			par := &list[i]
+			if typ := par.name; typ != nil {
+				par.typ = typ
+				par.name = nil
+			}
+		}
+	} else if named != len(list) {
+		// some named => all must be named
+		var errPos token.Pos // left-most error position (or invalid)
+		var typ ast.Expr     // current type (from right to left)
+		for i := range list {
+			if par := &list[len(list)-i-1]; par.typ != nil {
+				typ = par.typ
+				if par.name == nil {
+					errPos = typ.Pos()
+					n := ast.NewIdent("_")
+					n.NamePos = errPos // correct position
+					par.name = n
+				}
+			} else if typ != nil {
+				par.typ = typ
+			} else {
+				// par.typ == nil && typ == nil => we only have a par.name
+				errPos = par.name.Pos()
+				par.typ = &ast.BadExpr{From: errPos, To: nodePos(n)}
+			}
+		}
+		if errPos.IsValid() {
+			if named == typed {
+				p.error(errPos, "missing parameter type")
+			} else {
+				p.error(errPos, "missing parameter name")
+			}
+		}
 	}
-}
 
-func (p *parser) parseTypeLit(n *parse.Node) ast.Expr {
-	n = n.Child(0)
-	switch n.Rule() {
-	case arrayType:
-	case structType:
-		return p.parseStructType(n)
-	case pointerType:
-		return p.parsePointerType(n)
-	case funcType:
-	case interfaceType:
-		return p.parseInterfaceType(n)
-	case sliceType:
-	case mapType:
-	case channelType:
+	// Convert list to []*ast.Field.
+	// If list contains types only, each type gets its own ast.Field.
+	if named == 0 {
+		// parameter list consists of types only
+		for _, par := range list {
+			params = append(params, &ast.Field{Type: par.typ})
+		}
+		return
 	}
-	fmt.Println(n)
-	return nil
-}
 
-func (p *parser) parseInterfaceType(n *parse.Node) ast.Expr {
-	keywordPos := token.Pos(n.Child(0).Pos())
-	n = n.Child(1)
-	specs := ast.FieldList{
-		Opening: token.Pos(n.Child(0).Pos()),
-		Closing: token.Pos(n.LastChild().Pos()),
-	}
-	if n.ChildCount() > 2 {
-		eachListItem(methodSpec, n.Child(1), func(item *parse.Node) {
-			specs.List = append(specs.List, p.parseMethodSpec(item))
-		})
+	// If the parameter list consists of named parameters with types,
+	// collect all names with the same types into a single ast.Field.
+	var names []*ast.Ident
+	var typ ast.Expr
+	addParams := func() {
+		field := &ast.Field{Names: names, Type: typ}
+		params = append(params, field)
+		names = nil
 	}
-	return &ast.InterfaceType{
-		Interface: keywordPos,
-		Methods:   &specs,
-	}
-}
-
-func (p *parser) parseMethodSpec(n *parse.Node) *ast.Field {
-	fmt.Println(n)
-	return nil
-}
-
-func (p *parser) parsePointerType(n *parse.Node) ast.Expr {
-	return &ast.StarExpr{
-		Star: token.Pos(n.Child(0).Pos()),
-		X:    p.parseType(n.Child(1)),
+	for _, par := range list {
+		if par.typ != typ {
+			if len(names) > 0 {
+				addParams()
+			}
+			typ = par.typ
+		}
+		names = append(names, par.name)
 	}
-}
-
-func (p *parser) parseStructType(n *parse.Node) ast.Expr {
-	struct_ := n.Child(0)
-	return &ast.StructType{
-		Struct: token.Pos(struct_.Pos()),
-		// TODO: Fields
+	if len(names) > 0 {
+		addParams()
 	}
+	return
 }
@@ -18,7 +18,7 @@ import (
 
 var fset = token.NewFileSet()
 
-var testRoot = runtime.GOROOT() + "/src/pkg/go/parser/"
+var testRoot = runtime.GOROOT() + "/src/go/parser/"
 var validFiles = []string{
 	testRoot + "parser.go",
 	testRoot + "parser_test.go",
@@ -28,7 +28,7 @@ var validFiles = []string{
 
 func TestParse(t *testing.T) {
 	for _, filename := range validFiles {
-		_, err := StdParseFile(fset, filename, nil, DeclarationErrors)
+		_, err := ParseFile(fset, filename, nil, DeclarationErrors)
 		if err != nil {
 			t.Fatalf("ParseFile(%s): %v", filename, err)
 		}
@@ -138,7 +138,7 @@ func TestColonEqualsScope(t *testing.T) {
 }
 
 func TestVarScope(t *testing.T) {
-	f, err := StdParseFile(fset, "", `package p; func f() { var x, y, z = x, y, z }`, 0)
+	f, err := ParseFile(fset, "", `package p; func f() { var x, y, z = x, y, z }`, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -168,7 +168,7 @@ var x int
 func f() { L: }
 `
 
-	f, err := StdParseFile(fset, "", src, 0)
+	f, err := ParseFile(fset, "", src, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -206,7 +206,7 @@ func f() { L: }
 }
 
 func TestUnresolved(t *testing.T) {
-	f, err := StdParseFile(fset, "", `
+	f, err := ParseFile(fset, "", `
 package p
 //
 func f1a(int)
@@ -301,7 +301,7 @@ var imports = map[string]bool{
 func TestImports(t *testing.T) {
 	for path, isValid := range imports {
 		src := fmt.Sprintf("package p; import %s", path)
-		_, err := StdParseFile(fset, "", src, 0)
+		_, err := ParseFile(fset, "", src, 0)
 		switch {
 		case err != nil && isValid:
 			t.Errorf("ParseFile(%s): got %v; expected no error", src, err)
@@ -406,7 +406,7 @@ func checkFieldComments(t *testing.T, file *ast.File, fieldname, lead, line stri
 }
 
 func TestLeadAndLineComments(t *testing.T) {
-	f, err := StdParseFile(fset, "", `
+	f, err := ParseFile(fset, "", `
 package p
 type T struct {
 	/* F1 lead comment */
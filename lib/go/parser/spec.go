@@ -15,13 +15,7 @@ var (
 	newRule = parse.NewRule
 
 	mList = func(item *parse.R, sep string) *parse.R {
-		items := con(item, sep).AtLeast(1)
-		return or(
-			item,
-			con(item, sep),
-			con(items, item),
-			con(items, item, sep),
-		)
+		return item.SepEndBy(term(sep))
 	}
 
 	sList = func(item *parse.R, sep string) *parse.R {
@@ -39,11 +33,7 @@ var (
 	}
 
 	declList = func(item *parse.R) *parse.R {
-		return or(
-			item,
-			con("(", ")"),
-			con("(", semiList(item), ")"),
-		)
+		return or(item, con("(", semiList(item), ")"))
 	}
 
 	identifier   = term("identifier")
@@ -54,141 +44,103 @@ var (
 	imaginaryLit = term("imag")
 
 	// sourceExpr
-	sourceExpr = con(or(expr, type_), ";", EOF).As("sourceExpr")
+	sourceExpr = or(
+		con(expr, EOF),
+		con(expr, ";", EOF)).As("sourceExpr")
 
 	// Packages
 
-	sourceFile = con(packageClause, ";", or(
-		EOF,
-		con(importDecls, EOF),
-		con(topLevelDecls, EOF),
-		con(importDecls, topLevelDecls, EOF),
-	)).As("sourceFile")
-	importDecls   = con(importDecl, ";").AtLeast(1).As("importDecls")
-	topLevelDecls = con(topLevelDecl, ";").AtLeast(1).As("topLevelDecls")
+	sourceFile    = con(packageClause, ";", importDecls, topLevelDecls, EOF).As("sourceFile")
+	importDecls   = con(importDecl, ";").ZeroOrMore().As("importDecls")
+	topLevelDecls = con(topLevelDecl, ";").ZeroOrMore().As("topLevelDecls")
 
-	packageClause = con("package", packageName).As("packageClause")
-	packageName   = identifier // As??
+	packageClause = con("package", identifier).As("packageClause")
 
 	importDecl = con("import", declList(importSpec)).As("importDecl")
 	importSpec = or(
-		importPath,
-		con(packageName, importPath),
-		con("dot", importPath)).As("importSpec")
-	importPath = stringLit
+		stringLit,
+		con(identifier, stringLit),
+		con(".", stringLit)).As("importSpec")
 
 	// Declarations
 
 	decl         = or(constDecl, typeDecl, varDecl).As("decl")
-	topLevelDecl = or(decl, funcDecl, methodDecl).As("topLevelDecl")
+	topLevelDecl = or(decl, funcDecl).As("topLevelDecl")
 
 	constDecl = con("const", declList(constSpec)).As("constDecl")
-	valueSpec = or(
+	constSpec = or(
 		identifierList,
+		con(identifierList, type_),
 		con(identifierList, "=", exprList),
 		con(identifierList, type_, "=", exprList),
-	).As("valueSpec")
-	constSpec      = valueSpec
+	).As("constSpec")
 	identifierList = commaList(identifier).As("identifierList")
 	exprList       = commaList(expr).As("exprList")
 
 	typeDecl = con("type", declList(typeSpec)).As("typeDecl")
-	typeSpec = con(identifier, type_).As("typeSpec")
+	// A type spec like type T[P *C] int is either an array type or a generic
+	// type, it is resolved like go/parser does, see arrayOrTypeParams.
+	typeSpec = or(
+		con(identifier, type_),
+		con(identifier, "=", type_),
+		con(identifier, typeParams, type_),
+		con(identifier, typeParams, "=", type_)).As("typeSpec")
 
 	varDecl = con("var", declList(varSpec)).As("varDecl")
 	varSpec = or(
-		valueSpec,
 		con(identifierList, type_),
+		con(identifierList, "=", exprList),
+		con(identifierList, type_, "=", exprList),
 	).As("varSpec")
 
-	shortVarDecl = con(identifierList, ":=", exprList).As("shortVarDecl")
-
-	funcDecl = con("func", funcName, or(func_, signature)).As("funcDecl")
-	funcName = identifier
-	func_    = con(signature, funcBody).As("func_")
-	funcBody = block
-
-	methodDecl = con("func", receiver, methodName, or(func_, signature)).As("methodDecl")
-	receiver   = con("(", or(
-		baseTypeName,
-		con(identifier, baseTypeName),
-		con("*", baseTypeName),
-		con(identifier, "*", baseTypeName),
-	), ")").As("receiver")
-	baseTypeName = identifier
-
-	// block
-
-	block = or(
-		con("{", "}"),
-		con("{", semiList(stmt), "}"),
-	).As("block")
-	stmtList = con(stmt, ";").AtLeast(1).As("stmtList")
+	// the receiver is distinguished from the function name by its parameters
+	funcDecl = or(
+		con("func", identifier, signature),
+		con("func", identifier, signature, block),
+		con("func", identifier, typeParams, signature),
+		con("func", identifier, typeParams, signature, block),
+		con("func", parameters, identifier, signature),
+		con("func", parameters, identifier, signature, block),
+		con("func", parameters, identifier, typeParams, signature),
+		con("func", parameters, identifier, typeParams, signature, block),
+	).As("funcDecl")
+
+	// Blocks
+
+	// A statement list is a list of statements terminated by semicolons, the
+	// last semicolon is optional before a closing "}". A lone semicolon is an
+	// empty statement, and a label may be followed by no statement at all.
+	block    = con("{", stmtList, "}").As("block")
+	stmtList = con(stmtItem.ZeroOrMore(), stmtLast.Optional()).As("stmtList")
+	stmtItem = newRule().As("stmtItem")
+	_        = stmtItem.Define(or(
+		con(stmt, ";"),
+		";",
+		con(identifier, ":", stmtItem)))
+	stmtLast = newRule().As("stmtLast")
+	_        = stmtLast.Define(or(
+		stmt,
+		con(identifier, ":"),
+		con(identifier, ":", stmtLast)))
 
 	// Statements
-	stmt = newRule().As("stmt")
-	_    = stmt.Define(or(
-		decl, labeledStmt, simpleStmt,
+
+	stmt = or(
+		decl, simpleStmt,
 		goStmt, returnStmt, breakStmt, continueStmt, gotoStmt,
 		fallthroughStmt, block, ifStmt, switchStmt, selectStmt, forStmt,
-		deferStmt))
-	simpleStmt = or( /*emptyStmt,*/ exprStmt, sendStmt, incDecStmt, assignment, shortVarDecl).As("simpleStmt")
-
-	//emptyStmt = null
-
-	labeledStmt = con(label, ":", stmt).As("labeledStmt")
-	label       = identifier
-
-	exprStmt = expr
-
-	sendStmt = con(channel, "<-", expr).As("sendStmt")
-	channel  = expr
-
-	incDecStmt = con(expr, or("++", "--")).As("incDecStmt")
-
-	assignment = con(exprList, assignOp, exprList).As("assignment")
-	assignOp   = or("=", "+=", "-=", "|=", "^=", "*=", "/=", "%=", "<<=", ">>=", "&=", "&^=").As("assignOp")
-
-	condition = expr
-	initCond  = or(condition, con(simpleStmt, ";", condition))
-	ifStmt    = newRule().As("ifStmt")
-	_         = ifStmt.Define(con("if",
-		initCond,
-		or(block, con(block, "else", or(ifStmt, block)))))
-
-	switchStmt     = or(exprSwitchStmt, typeSwitchStmt).As("switchStmt")
-	exprSwitchStmt = con("switch", or("{", con(initCond, "{")), or("}", con(exprCaseClause.AtLeast(1), "}"))).As("exprSwitchStmt")
-	commaStmtList  = or(":", con(":", stmtList))
-	exprCaseClause = con(exprSwitchCase, commaStmtList).As("exprCaseClause")
-	exprSwitchCase = con("case", or(exprList, "default")).As("exprSwitchCase")
-
-	typeSwitchStmt  = con("switch", or(typeSwitchGuard, con(simpleStmt, ";", typeSwitchGuard)), "{", or("}", con(typeCaseClause.AtLeast(1), "}"))).As("typeSwitchStmt")
-	typeSwitchGuard = con(or(primaryExpr, con(identifier, ":=", primaryExpr)), ".", "(", "type", ")").As("typeSwitchGuard")
-	typeCaseClause  = con(typeSwitchCase, commaStmtList).As("typeCaseClause")
-	typeSwitchCase  = or(con("case", typeList), "default").As("typeSwitchCase")
-	typeList        = commaList(type_).As("typeList")
-
-	forStmt   = con("for", or(block, con(or(condition, forClause, rangeClause), block))).As("forStmt")
-	forClause = con(
-		or(";", con(initStmt, ";")),
-		or(";", con(condition, ";")),
-		or(";", con(postStmt, ";")),
-	).As("forClause")
-	initStmt    = simpleStmt
-	postStmt    = simpleStmt
-	rangeClause = con(or(con(exprList, "="), con(identifierList, ":=")), "range", expr).As("rangeClause")
-
-	goStmt = con("go", expr).As("goStmt")
-
-	selectStmt = con("select", or(
-		con("{", "}"),
-		con("{", commClause.AtLeast(1), "}"))).As("selectStmt")
-	commClause = con(commCase, commaStmtList).As("commClause")
-	commCase   = or(con("case", or(sendStmt, recvStmt)), "default").As("commCase")
-	recvStmt   = or(
-		recvExpr,
-		con(or(con(exprList, "="), con(identifierList, ":=")), recvExpr)).As("recvStmt")
-	recvExpr = expr
+		deferStmt).As("stmt")
+	simpleStmt = or(
+		expr,
+		con(expr, "<-", expr),
+		con(expr, "++"),
+		con(expr, "--"),
+		con(exprList, assignOp, exprList),
+	).As("simpleStmt")
+	assignOp = or(":=", "=", "+=", "-=", "|=", "^=", "*=", "/=", "%=", "<<=", ">>=", "&=", "&^=").As("assignOp")
+
+	goStmt    = con("go", callExpr).As("goStmt")
+	deferStmt = con("defer", callExpr).As("deferStmt")
 
 	returnStmt = or(
 		"return",
@@ -196,149 +148,252 @@ var (
 
 	breakStmt = or(
 		"break",
-		con("break", label)).As("breakStmt")
+		con("break", identifier)).As("breakStmt")
 
 	continueStmt = or(
 		"continue",
-		con("continue", label)).As("continueStmt")
+		con("continue", identifier)).As("continueStmt")
 
-	gotoStmt = con("goto", label).As("gotoStmt")
+	gotoStmt = con("goto", identifier).As("gotoStmt")
 
 	fallthroughStmt = term("fallthrough")
 
-	//deferStmt = con("defer", expr).As("deferStmt")
-	deferStmt = con("defer", callExpr).As("deferStmt") // restrict to callExpr
-
-	// Expr
-
-	expr      = newRule().As("expr")
-	_         = expr.Define(or(unaryExpr, con(expr, binaryOp, unaryExpr)))
-	unaryExpr = newRule().As("unaryExpr")
-	_         = unaryExpr.Define(or(primaryExpr, con(unaryOp, unaryExpr)))
-
-	operand     = or(literal, operandName /*methodExpr,*/, con("(", expr, ")")).As("operand") // remove ambiguous
-	literal     = or(basicLit, compositeLit, funcLit).As("literal")
-	basicLit    = or(intLit, floatLit, imaginaryLit, runeLit, stringLit).As("basicLit")
-	operandName = identifier // remove ambiguous
-	//operandName = or(identifier, qualifiedIdent).As("operandName")
-
-	qualifiedIdent = con(packageName, ".", identifier).As("qualifiedIdent")
-
-	compositeLit = con(literalType, literalValue).As("compositeLit")
-	literalType  = or(arrayType, structType, con("[", "...", "]", elementType),
-		sliceType, mapType, typeName).As("literalType")
+	// The header of an if, switch or for statement is parsed with the header
+	// variants of the expression rules, see defineExpr.
+	headerSimpleStmt = or(
+		headerExpr,
+		con(headerExpr, "<-", headerExpr),
+		con(headerExpr, "++"),
+		con(headerExpr, "--"),
+		con(headerExprList, assignOp, headerExprList),
+	).As("headerSimpleStmt")
+	headerExprList = commaList(headerExpr).As("headerExprList")
+
+	ifStmt = newRule().As("ifStmt")
+	_      = ifStmt.Define(or(
+		con("if", ifHeader, block),
+		con("if", ifHeader, block, "else", ifStmt),
+		con("if", ifHeader, block, "else", block)))
+	ifHeader = or(
+		headerExpr,
+		con(headerSimpleStmt, ";", headerExpr),
+		con(";", headerExpr)).As("ifHeader")
+
+	switchStmt = or(
+		con("switch", "{", caseClause.ZeroOrMore(), "}"),
+		con("switch", switchHeader, "{", caseClause.ZeroOrMore(), "}"),
+	).As("switchStmt")
+	switchHeader = or(
+		switchGuard,
+		";",
+		con(";", switchGuard),
+		con(headerSimpleStmt, ";"),
+		con(headerSimpleStmt, ";", switchGuard),
+	).As("switchHeader")
+	switchGuard     = or(headerExpr, typeSwitchGuard).As("switchGuard")
+	typeSwitchGuard = or(
+		con(headerPrimaryExpr, ".", "(", "type", ")"),
+		con(identifier, ":=", headerPrimaryExpr, ".", "(", "type", ")"),
+	).As("typeSwitchGuard")
+	caseClause = or(
+		con("case", exprList, ":", stmtList),
+		con("default", ":", stmtList)).As("caseClause")
+
+	selectStmt = con("select", "{", commClause.ZeroOrMore(), "}").As("selectStmt")
+	commClause = con(commCase, ":", stmtList).As("commClause")
+	commCase   = or(
+		con("case", expr, "<-", expr),
+		con("case", exprList, "=", expr),
+		con("case", exprList, ":=", expr),
+		con("case", expr),
+		"default").As("commCase")
+
+	forStmt = or(
+		con("for", block),
+		con("for", headerExpr, block),
+		con("for", forClause, block),
+		con("for", rangeClause, block)).As("forStmt")
+	forClause = con(
+		headerSimpleStmt.Optional(), ";",
+		headerExpr.Optional(), ";",
+		headerSimpleStmt.Optional()).As("forClause")
+	rangeClause = or(
+		con("range", headerExpr),
+		con(headerExprList, "=", "range", headerExpr),
+		con(headerExprList, ":=", "range", headerExpr),
+	).As("rangeClause")
+
+	// Expressions
+
+	expr        = newRule().As("expr")
+	unaryExpr   = newRule().As("unaryExpr")
+	primaryExpr = newRule().As("primaryExpr")
+	callExpr    = con(primaryExpr, call).As("callExpr")
+	_           = defineExpr(expr, unaryExpr, primaryExpr,
+		callExpr,
+		con(typeName, literalValue),
+		con(typeName, index, literalValue),
+		con(literalType, literalValue))
+
+	headerExpr        = newRule().As("headerExpr")
+	headerUnaryExpr   = newRule().As("headerUnaryExpr")
+	headerPrimaryExpr = newRule().As("headerPrimaryExpr")
+	_                 = defineExpr(headerExpr, headerUnaryExpr, headerPrimaryExpr,
+		con(headerPrimaryExpr, call),
+		con(literalType, literalValue))
+
+	// An operand may also be a type that is not a type name, so that
+	// conversions and builtin calls are parsed as call expressions. A type
+	// cannot be indexed, so []T[P] is a slice of the generic type T[P].
+	operand = or(
+		basicLit,
+		identifier,
+		con("(", expr, ")"),
+		funcLit,
+		con("func", signature).NotFollowedBy(term("{"), term("[")),
+		newRule().Ref(arrayType).NotFollowedBy(term("[")),
+		newRule().Ref(sliceType).NotFollowedBy(term("[")),
+		structType, interfaceType,
+		newRule().Ref(mapType).NotFollowedBy(term("[")),
+		newRule().Ref(chanType).NotFollowedBy(term("[")),
+	).As("operand")
+	basicLit = or(intLit, floatLit, imaginaryLit, runeLit, stringLit).As("basicLit")
+
+	literalType  = or(arrayType, sliceType, structType, mapType).As("literalType")
 	literalValue = newRule().As("literalValue")
-	_            = literalValue.Define(or(con("{", "}"), con("{", elementList, "}")))
-	elementList  = mList(element, ",").As("elementList")
-	element      = or(
+	_            = literalValue.Define(or(
+		con("{", "}"),
+		con("{", elementList, "}"),
+		con("{", elementList, ",", "}")))
+	elementList = commaList(element).As("elementList")
+	element     = or(
 		value,
-		con(key, ":", value)).As("element")
-	key = expr
-	//key          = or(fieldName, elementIndex).As("key")
-	//fieldName    = identifier
-	//elementIndex = expr
+		con(value, ":", value)).As("element")
 	value = or(expr, literalValue).As("value")
 
-	funcLit = con("func", func_).As("funcLit")
+	funcLit = con("func", signature, block).As("funcLit")
 
-	primaryExpr = newRule().As("primaryExpr")
-	_           = primaryExpr.Define(or(
-		operand,
-		/*conversion, builtinCall,*/ // remove ambiguous
-		con(primaryExpr, selector),
-		con(primaryExpr, index),
-		con(primaryExpr, slice),
-		con(primaryExpr, typeAssertion),
-		callExpr)) //callOrConversion
-	callExpr = con(primaryExpr, call)
 	selector = con(".", identifier).As("selector")
-	index    = con("[", expr, "]").As("index")
-	slice    = con("[", or(
-		":",
-		con(expr, ":"),
-		con(":", expr),
-		con(expr, ":", expr),
-		con(":", expr, ":", expr),
-		con(expr, ":", expr, ":", expr),
-	), "]").As("slice")
+
+	// An index with more than one expression is an instantiation, whose
+	// type arguments after the first one can only be types.
+	index = or(
+		con("[", expr, "]"),
+		con("[", expr, ",", "]"),
+		con("[", expr, ",", typeList, "]"),
+		con("[", expr, ",", typeList, ",", "]"),
+	).As("index")
+	slice = or(
+		con("[", ":", "]"),
+		con("[", expr, ":", "]"),
+		con("[", ":", expr, "]"),
+		con("[", expr, ":", expr, "]"),
+		con("[", ":", expr, ":", expr, "]"),
+		con("[", expr, ":", expr, ":", expr, "]"),
+	).As("slice")
 	typeAssertion = con(".", "(", type_, ")").As("typeAssertion")
 	call          = or(
 		con("(", ")"),
-		con("(", argumentList, ")"),
-		con("(", argumentList, ",", ")"),
+		con("(", exprList, ")"),
+		con("(", exprList, ",", ")"),
+		con("(", exprList, "...", ")"),
+		con("(", exprList, "...", ",", ")"),
 	).As("call")
-	argumentList = or(exprList, con(exprList, "...")).As("argumentList")
-
-	binaryOp = or("||", "&&", relOp, addOp, mulOp).As("binaryOp")
-	relOp    = or("==", "!=", "<", "<=", ">", ">=")
-	addOp    = or("+", "-", "|", "^")
-	mulOp    = or("*", "/", "%", "<<", ">>", "&", "&^")
-	unaryOp  = or("+", "-", "!", "^", "*", "&", "<-")
-
-	methodExpr   = con(receiverType, ".", methodName).As("methodExpr")
-	receiverType = newRule().As("receiverType")
-	_            = receiverType.Define(or(typeName, con("(", "*", typeName, ")"), con("(", receiverType, ")")))
 
-	// remove ambiguous
-	//conversion = con(type_, "(", expr, opt(","), ")").As("conversion")
-
-	// Built-in functions
-
-	//builtinCall = con(identifier, "(", opt(builtinArgs, opt(",")), ")").As("builtinCall")
-	//builtinArgs = or(con(type_, opt(",", argumentList)), argumentList).As("builtinArgs")
+	relOp   = or("==", "!=", "<", "<=", ">", ">=")
+	addOp   = or("+", "-", "|", "^")
+	mulOp   = or("*", "/", "%", "<<", ">>", "&", "&^")
+	unaryOp = or("+", "-", "!", "^", "*", "&", "<-", "~")
 
 	// Types
 
-	type_    = newRule().As("type_")
-	_        = type_.Define(or(typeName, typeLit, con("(", type_, ")")))
-	typeName = or(identifier, qualifiedIdent).As("typeName")
-	typeLit  = or(arrayType, structType, pointerType, funcType, interfaceType,
-		sliceType, mapType, channelType).As("typeLit")
+	type_ = newRule().As("type_")
+	_     = type_.Define(or(typeName, genericType, typeLit, con("(", type_, ")")))
 
-	arrayType   = con("[", arrayLength, "]", elementType).As("arrayType")
-	arrayLength = expr
-	elementType = type_
+	// A type name followed by "." is always a qualified identifier, e.g. the
+	// result type of func() a.b.
+	typeName = or(
+		newRule().Ref(identifier).NotFollowedBy(term(".")),
+		qualifiedIdent).As("typeName")
+	qualifiedIdent = con(identifier, ".", identifier).As("qualifiedIdent")
 
-	sliceType = con("[", "]", elementType).As("sliceType")
+	genericType = con(typeName, typeArgs).As("genericType")
+	typeArgs    = or(
+		con("[", typeList, "]"),
+		con("[", typeList, ",", "]")).As("typeArgs")
+	typeList = commaList(type_).As("typeList")
 
-	structType     = con("struct", "{", semiList(fieldDecl), "}").As("structType")
-	field          = or(con(identifierList, type_), anonymousField)
-	fieldDecl      = or(field, con(field, tag)).As("fieldDecl")
-	anonymousField = or(typeName, con("*", typeName)).As("anonymousField")
-	tag            = stringLit
+	typeLit = or(arrayType, sliceType, structType, pointerType, funcType,
+		interfaceType, mapType, chanType, recvChanType).As("typeLit")
 
-	pointerType = con("*", baseType).As("pointerType")
-	baseType    = type_
+	arrayType = or(
+		con("[", expr, "]", type_),
+		con("[", "...", "]", type_)).As("arrayType")
 
-	funcType   = con("func", signature).As("funcType")
-	signature  = or(parameters, con(parameters, result)).As("signature")
-	result     = or(parameters, type_).As("result")
+	sliceType = con("[", "]", type_).As("sliceType")
+
+	structType = con("struct", "{", semiList(fieldDecl), "}").As("structType")
+	fieldDecl  = or(
+		con(identifierList, type_),
+		con(identifierList, type_, stringLit),
+		embeddedField,
+		con(embeddedField, stringLit),
+	).As("fieldDecl")
+	embeddedField = or(
+		typeName,
+		genericType,
+		con("*", typeName),
+		con("*", genericType)).As("embeddedField")
+
+	pointerType = con("*", type_).As("pointerType")
+
+	// A signature is followed by its result whenever a type can begin, e.g.
+	// func() *T{} is a composite literal rather than a multiplication.
+	funcType  = con("func", signature).As("funcType")
+	signature = or(
+		newRule().Ref(parameters).NotFollowedBy(identifier, term("("),
+			term("["), term("*"), term("<-"), term("func"), term("map"),
+			term("chan"), term("struct"), term("interface")),
+		con(parameters, result)).As("signature")
+	result     = newRule().As("result")
+	_          = result.Define(or(parameters, typeName, genericType, typeLit))
 	parameters = or(
 		con("(", ")"),
-		con("(", parameterList, ")")).As("parameters")
-	parameterList = mList(parameterDecl, ",").As("parameterList")
+		con("(", parameterList, ")"),
+		con("(", parameterList, ",", ")"),
+	).As("parameters")
+	parameterList = commaList(parameterDecl).As("parameterList")
 	parameterDecl = or(
 		type_,
-		con(identifierList, type_),
-		con(identifierList, "...", type_)).As("parameterDecl")
-
-	interfaceType = con("interface", or(
-		con("{", "}"),
-		con("{", methodSpecs, "}"))).As("interfaceType")
-	methodSpec        = or(con(methodName, signature), interfaceTypeName).As("methodSpec")
-	methodSpecs       = semiList(methodSpec)
-	methodName        = identifier
-	interfaceTypeName = typeName
-
-	mapType = con("map", "[", keyType, "]", elementType).As("mapType")
-	keyType = type_
-
-	channelType = con(
-		or(
-			"chan",
-			con("chan", "<-"),
-			con("<-", "chan"),
-		), elementType).As("channelType")
+		con(identifier, type_),
+		con(identifier, "...", type_),
+		con("...", type_),
+	).As("parameterDecl")
+
+	interfaceType = con("interface", "{", semiList(methodSpec), "}").As("interfaceType")
+	methodSpec    = or(con(identifier, signature), typeElem).As("methodSpec")
+
+	// Type parameters and type sets
+
+	typeParams = or(
+		con("[", typeParamList, "]"),
+		con("[", typeParamList, ",", "]")).As("typeParams")
+	typeParamList = commaList(typeParamDecl).As("typeParamList")
+	typeParamDecl = con(identifierList, typeElem).As("typeParamDecl")
+	typeElem      = sList(typeTerm, "|").As("typeElem")
+	typeTerm      = or(type_, con("~", type_)).As("typeTerm")
+
+	mapType = con("map", "[", type_, "]", type_).As("mapType")
+
+	// The element type of a bidirectional channel cannot be a receive-only
+	// channel type, chan <-chan T is parsed as chan<- (chan T).
+	chanType = newRule().As("chanType")
+	_        = chanType.Define(or(
+		con("chan", chanElem),
+		con("chan", "<-", type_)))
+	recvChanType = con("<-", "chan", type_).As("recvChanType")
+	chanElem     = or(typeName, genericType, arrayType, sliceType, structType, pointerType,
+		funcType, interfaceType, mapType, chanType, con("(", type_, ")")).As("chanElem")
 
 	tokenTable = toTokenTable([]interface{}{
 		//token.ILLEGAL:        ,
@@ -397,6 +452,7 @@ var (
 		token.RBRACE:         "}",
 		token.SEMICOLON:      ";",
 		token.COLON:          ":",
+		token.TILDE:          "~",
 		token.BREAK:          "break",
 		token.CASE:           "case",
 		token.CHAN:           "chan",
@@ -425,6 +481,33 @@ var (
 	})
 )
 
+// defineExpr defines the rules of an expression, its unary and primary
+// expressions, and returns expr. The alts are the primary expressions besides
+// the operands, selectors, indexes, slices and type assertions.
+//
+// The rules are defined twice: the plain variant and the header variant used
+// within the header of an if, switch or for statement, where a composite
+// literal of a type name has to be parenthesized, otherwise its opening brace
+// would be taken as the block of the statement.
+func defineExpr(expr, unaryExpr, primaryExpr *parse.R, alts ...interface{}) *parse.R {
+	expr.Define(or(
+		unaryExpr,
+		con(expr, "||", expr).Left().Prec(1),
+		con(expr, "&&", expr).Left().Prec(2),
+		con(expr, relOp, expr).Left().Prec(3),
+		con(expr, addOp, expr).Left().Prec(4),
+		con(expr, mulOp, expr).Left().Prec(5)))
+	unaryExpr.Define(or(primaryExpr, con(unaryOp, unaryExpr)))
+	primaryExpr.Define(or(append([]interface{}{
+		operand,
+		con(primaryExpr, selector),
+		con(primaryExpr, index),
+		con(primaryExpr, slice),
+		con(primaryExpr, typeAssertion),
+	}, alts...)...))
+	return expr
+}
+
 func toTokenTable(a []interface{}) []*parse.R {
 	rs := make([]*parse.R, len(a))
 	for i := range a {
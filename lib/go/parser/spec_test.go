@@ -0,0 +1,17 @@
+package parser
+
+import (
+	"testing"
+
+	"h12.io/gombi/parse"
+	"h12.io/gspec"
+)
+
+func TestSpec(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, root := range []*parse.R{sourceFile, sourceExpr} {
+		a := parse.Analyze(root)
+		expect(root.Name(), a.Issues.Err()).Equal(nil)
+		expect(a.LeftRecursive(expr)).Equal(true)
+	}
+}
@@ -78,8 +78,12 @@ func (p *std_parser) init(fset *token.FileSet, filename string, src []byte, mode
 	p.next()
 }
 
-// A bailout panic is raised to indicate early termination.
-type bailout struct{}
+// A bailout panic is raised to indicate early termination. pos and msg are
+// only populated when bailing out of object resolution.
+type bailout struct {
+	pos token.Pos
+	msg string
+}
 
 func (p *std_parser) error(pos token.Pos, msg string) {
 	epos := p.file.Position(pos)
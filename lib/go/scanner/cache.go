@@ -1,9357 +1,9192 @@
+// Code generated by gombi gen. DO NOT EDIT.
 
 package scanner
+
 import (
 	"h12.io/dfa"
 	"h12.io/gombi/scan"
 )
-var tokMatcherCache = 
-&scan.Matcher{
-EOF: 1,
-Illegal: 0,
-M: &dfa.M{
-States: dfa.States{
-{
-Table: dfa.TransTable{
-{0x09, 0x09, 1},
-{0x0a, 0x0a, 2},
-{0x0d, 0x0d, 3},
-{0x20, 0x20, 4},
-{0x21, 0x21, 5},
-{0x22, 0x22, 6},
-{0x25, 0x25, 7},
-{0x26, 0x26, 8},
-{0x27, 0x27, 9},
-{0x28, 0x28, 10},
-{0x29, 0x29, 11},
-{0x2a, 0x2a, 12},
-{0x2b, 0x2b, 13},
-{0x2c, 0x2c, 14},
-{0x2d, 0x2d, 15},
-{0x2e, 0x2e, 16},
-{0x2f, 0x2f, 17},
-{0x30, 0x30, 18},
-{0x31, 0x39, 19},
-{0x3a, 0x3a, 20},
-{0x3b, 0x3b, 21},
-{0x3c, 0x3c, 22},
-{0x3d, 0x3d, 23},
-{0x3e, 0x3e, 24},
-{0x41, 0x5a, 25},
-{0x5b, 0x5b, 26},
-{0x5d, 0x5d, 27},
-{0x5e, 0x5e, 28},
-{0x5f, 0x5f, 25},
-{0x60, 0x60, 29},
-{0x61, 0x61, 25},
-{0x62, 0x62, 30},
-{0x63, 0x63, 31},
-{0x64, 0x64, 32},
-{0x65, 0x65, 33},
-{0x66, 0x66, 34},
-{0x67, 0x67, 35},
-{0x68, 0x68, 25},
-{0x69, 0x69, 36},
-{0x6a, 0x6c, 25},
-{0x6d, 0x6d, 37},
-{0x6e, 0x6f, 25},
-{0x70, 0x70, 38},
-{0x71, 0x71, 25},
-{0x72, 0x72, 39},
-{0x73, 0x73, 40},
-{0x74, 0x74, 41},
-{0x75, 0x75, 25},
-{0x76, 0x76, 42},
-{0x77, 0x7a, 25},
-{0x7b, 0x7b, 43},
-{0x7c, 0x7c, 44},
-{0x7d, 0x7d, 45},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 59},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 60},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 64},
-{0xe0, 0xe0, 65},
-{0xe1, 0xe1, 66},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 72},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 74},
-{0xf0, 0xf0, 75},
-
-}},
-{
-Label: 89,
-Table: dfa.TransTable{
-{0x09, 0x09, 1},
-{0x0d, 0x0d, 3},
-{0x20, 0x20, 4},
-
-}},
-{
-Label: 88,
-},
-{
-Label: 89,
-Table: dfa.TransTable{
-{0x09, 0x09, 1},
-{0x0d, 0x0d, 3},
-{0x20, 0x20, 4},
-
-}},
-{
-Label: 89,
-Table: dfa.TransTable{
-{0x09, 0x09, 1},
-{0x0d, 0x0d, 3},
-{0x20, 0x20, 4},
-
-}},
-{
-Label: 45,
-Table: dfa.TransTable{{0x3d, 0x3d, 76},
-}},
-{
-Table: dfa.TransTable{
-{0x01, 0x09, 6},
-{0x0b, 0x21, 6},
-{0x22, 0x22, 77},
-{0x23, 0x5b, 6},
-{0x5c, 0x5c, 78},
-{0x5d, 0x7f, 6},
-{0xc2, 0xdf, 79},
-{0xe0, 0xe0, 80},
-{0xe1, 0xee, 81},
-{0xef, 0xef, 82},
-{0xf0, 0xf0, 83},
-{0xf1, 0xf3, 84},
-{0xf4, 0xf4, 85},
-
-}},
-{
-Label: 18,
-Table: dfa.TransTable{{0x3d, 0x3d, 86},
-}},
-{
-Label: 19,
-Table: dfa.TransTable{
-{0x26, 0x26, 87},
-{0x3d, 0x3d, 88},
-{0x5e, 0x5e, 89},
-
-}},
-{
-Table: dfa.TransTable{
-{0x01, 0x09, 90},
-{0x0b, 0x26, 90},
-{0x28, 0x5b, 90},
-{0x5c, 0x5c, 91},
-{0x5d, 0x7f, 90},
-{0xc2, 0xdf, 92},
-{0xe0, 0xe0, 93},
-{0xe1, 0xee, 94},
-{0xef, 0xef, 95},
-{0xf0, 0xf0, 96},
-{0xf1, 0xf3, 97},
-{0xf4, 0xf4, 98},
-
-}},
-{
-Label: 51,
-},
-{
-Label: 56,
-},
-{
-Label: 16,
-Table: dfa.TransTable{{0x3d, 0x3d, 99},
-}},
-{
-Label: 14,
-Table: dfa.TransTable{
-{0x2b, 0x2b, 100},
-{0x3d, 0x3d, 101},
-
-}},
-{
-Label: 54,
-},
-{
-Label: 15,
-Table: dfa.TransTable{
-{0x2d, 0x2d, 102},
-{0x3d, 0x3d, 103},
-
-}},
-{
-Label: 55,
-Table: dfa.TransTable{
-{0x2e, 0x2e, 104},
-{0x30, 0x39, 105},
-
-}},
-{
-Label: 17,
-Table: dfa.TransTable{
-{0x2a, 0x2a, 106},
-{0x2f, 0x2f, 107},
-{0x3d, 0x3d, 108},
-
-}},
-{
-Label: 7,
-Table: dfa.TransTable{
-{0x2e, 0x2e, 109},
-{0x30, 0x37, 110},
-{0x38, 0x39, 111},
-{0x45, 0x45, 112},
-{0x58, 0x58, 113},
-{0x65, 0x65, 112},
-{0x69, 0x69, 114},
-{0x78, 0x78, 113},
-
-}},
-{
-Label: 7,
-Table: dfa.TransTable{
-{0x2e, 0x2e, 109},
-{0x30, 0x39, 19},
-{0x45, 0x45, 112},
-{0x65, 0x65, 112},
-{0x69, 0x69, 114},
-
-}},
-{
-Label: 60,
-Table: dfa.TransTable{{0x3d, 0x3d, 115},
-}},
-{
-Label: 59,
-},
-{
-Label: 42,
-Table: dfa.TransTable{
-{0x2d, 0x2d, 116},
-{0x3c, 0x3c, 117},
-{0x3d, 0x3d, 118},
-
-}},
-{
-Label: 44,
-Table: dfa.TransTable{{0x3d, 0x3d, 119},
-}},
-{
-Label: 43,
-Table: dfa.TransTable{
-{0x3d, 0x3d, 120},
-{0x3e, 0x3e, 121},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 52,
-},
-{
-Label: 57,
-},
-{
-Label: 21,
-Table: dfa.TransTable{{0x3d, 0x3d, 130},
-}},
-{
-Table: dfa.TransTable{
-{0x01, 0x5f, 29},
-{0x60, 0x60, 131},
-{0x61, 0x7f, 29},
-{0xc2, 0xdf, 132},
-{0xe0, 0xe0, 133},
-{0xe1, 0xee, 134},
-{0xef, 0xef, 135},
-{0xf0, 0xf0, 136},
-{0xf1, 0xf3, 137},
-{0xf4, 0xf4, 138},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 139},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 140},
-{0x62, 0x67, 25},
-{0x68, 0x68, 141},
-{0x69, 0x6e, 25},
-{0x6f, 0x6f, 142},
-{0x70, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 143},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6b, 25},
-{0x6c, 0x6c, 144},
-{0x6d, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 145},
-{0x62, 0x6e, 25},
-{0x6f, 0x6f, 146},
-{0x70, 0x74, 25},
-{0x75, 0x75, 147},
-{0x76, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6e, 25},
-{0x6f, 0x6f, 148},
-{0x70, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x65, 25},
-{0x66, 0x66, 149},
-{0x67, 0x6c, 25},
-{0x6d, 0x6d, 150},
-{0x6e, 0x6e, 151},
-{0x6f, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 152},
-{0x62, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 153},
-{0x62, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 154},
-{0x62, 0x64, 25},
-{0x65, 0x65, 155},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 156},
-{0x66, 0x73, 25},
-{0x74, 0x74, 157},
-{0x75, 0x76, 25},
-{0x77, 0x77, 158},
-{0x78, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x78, 25},
-{0x79, 0x79, 159},
-{0x7a, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 160},
-{0x62, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 53,
-},
-{
-Label: 20,
-Table: dfa.TransTable{
-{0x3d, 0x3d, 161},
-{0x7c, 0x7c, 162},
-
-}},
-{
-Label: 58,
-},
-{
-Table: dfa.TransTable{
-{0xaa, 0xaa, 25},
-{0xb5, 0xb5, 25},
-{0xba, 0xba, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x96, 25},
-{0x98, 0xb6, 25},
-{0xb8, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x81, 25},
-{0x86, 0x91, 25},
-{0xa0, 0xa4, 25},
-{0xac, 0xac, 25},
-{0xae, 0xae, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0xb0, 0xb4, 25},
-{0xb6, 0xb7, 25},
-{0xba, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x86, 0x86, 25},
-{0x88, 0x8a, 25},
-{0x8c, 0x8c, 25},
-{0x8e, 0xa1, 25},
-{0xa3, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xb5, 25},
-{0xb7, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x81, 25},
-{0x8a, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa7, 25},
-{0xb1, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x96, 25},
-{0x99, 0x99, 25},
-{0xa1, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x87, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0xaa, 25},
-{0xb0, 0xb2, 25},
-
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8a, 25},
-{0xae, 0xaf, 25},
-{0xb1, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x93, 25},
-{0x95, 0x95, 25},
-{0xa5, 0xa6, 25},
-{0xae, 0xaf, 25},
-{0xba, 0xbc, 25},
-{0xbf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 25},
-{0x92, 0xaf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x8d, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa5, 25},
-{0xb1, 0xb1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x8a, 0xaa, 25},
-{0xb4, 0xb5, 25},
-{0xba, 0xba, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa0, 0xa0, 163},
-{0xa1, 0xa1, 164},
-{0xa2, 0xa2, 165},
-{0xa4, 0xa4, 166},
-{0xa5, 0xa5, 167},
-{0xa6, 0xa6, 168},
-{0xa7, 0xa7, 169},
-{0xa8, 0xa8, 170},
-{0xa9, 0xa9, 171},
-{0xaa, 0xaa, 172},
-{0xab, 0xab, 173},
-{0xac, 0xac, 174},
-{0xad, 0xad, 175},
-{0xae, 0xae, 176},
-{0xaf, 0xaf, 177},
-{0xb0, 0xb0, 178},
-{0xb1, 0xb1, 179},
-{0xb2, 0xb2, 178},
-{0xb3, 0xb3, 180},
-{0xb4, 0xb4, 181},
-{0xb5, 0xb5, 182},
-{0xb6, 0xb6, 183},
-{0xb7, 0xb7, 184},
-{0xb8, 0xb8, 185},
-{0xb9, 0xb9, 184},
-{0xba, 0xba, 186},
-{0xbb, 0xbb, 187},
-{0xbc, 0xbc, 188},
-{0xbd, 0xbd, 189},
-{0xbe, 0xbe, 190},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 191},
-{0x81, 0x81, 192},
-{0x82, 0x82, 193},
-{0x83, 0x83, 194},
-{0x84, 0x88, 48},
-{0x89, 0x89, 195},
-{0x8a, 0x8a, 196},
-{0x8b, 0x8b, 197},
-{0x8c, 0x8c, 198},
-{0x8d, 0x8d, 199},
-{0x8e, 0x8e, 200},
-{0x8f, 0x8f, 201},
-{0x90, 0x90, 202},
-{0x91, 0x98, 48},
-{0x99, 0x99, 203},
-{0x9a, 0x9a, 204},
-{0x9b, 0x9b, 205},
-{0x9c, 0x9c, 206},
-{0x9d, 0x9d, 207},
-{0x9e, 0x9e, 208},
-{0x9f, 0x9f, 209},
-{0xa0, 0xa0, 58},
-{0xa1, 0xa1, 210},
-{0xa2, 0xa2, 211},
-{0xa3, 0xa3, 212},
-{0xa4, 0xa4, 213},
-{0xa5, 0xa5, 214},
-{0xa6, 0xa6, 215},
-{0xa7, 0xa7, 216},
-{0xa8, 0xa8, 217},
-{0xa9, 0xa9, 218},
-{0xaa, 0xaa, 219},
-{0xac, 0xac, 220},
-{0xad, 0xad, 221},
-{0xae, 0xae, 222},
-{0xaf, 0xaf, 223},
-{0xb0, 0xb0, 224},
-{0xb1, 0xb1, 225},
-{0xb3, 0xb3, 226},
-{0xb4, 0xb6, 48},
-{0xb8, 0xbb, 48},
-{0xbc, 0xbc, 227},
-{0xbd, 0xbd, 228},
-{0xbe, 0xbe, 229},
-{0xbf, 0xbf, 230},
-
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0x81, 231},
-{0x82, 0x82, 232},
-{0x84, 0x84, 233},
-{0x85, 0x85, 234},
-{0x86, 0x86, 235},
-{0xb0, 0xb0, 236},
-{0xb1, 0xb1, 237},
-{0xb2, 0xb2, 48},
-{0xb3, 0xb3, 238},
-{0xb4, 0xb4, 239},
-{0xb5, 0xb5, 240},
-{0xb6, 0xb6, 241},
-{0xb7, 0xb7, 242},
-{0xb8, 0xb8, 243},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 244},
-{0x81, 0x81, 202},
-{0x82, 0x82, 245},
-{0x83, 0x83, 246},
-{0x84, 0x84, 247},
-{0x85, 0x85, 48},
-{0x86, 0x86, 248},
-{0x87, 0x87, 249},
-{0x90, 0xbf, 48},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xb5, 48},
-{0xb6, 0xb6, 212},
-{0xb8, 0xbf, 48},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 48},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xbe, 48},
-{0xbf, 0xbf, 250},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x91, 48},
-{0x92, 0x92, 250},
-{0x93, 0x93, 251},
-{0x94, 0x97, 48},
-{0x98, 0x98, 252},
-{0x99, 0x99, 253},
-{0x9a, 0x9a, 254},
-{0x9b, 0x9b, 223},
-{0x9c, 0x9c, 255},
-{0x9d, 0x9d, 48},
-{0x9e, 0x9e, 256},
-{0x9f, 0x9f, 257},
-{0xa0, 0xa0, 258},
-{0xa1, 0xa1, 208},
-{0xa2, 0xa2, 259},
-{0xa3, 0xa3, 260},
-{0xa4, 0xa4, 261},
-{0xa5, 0xa5, 262},
-{0xa6, 0xa6, 263},
-{0xa7, 0xa7, 264},
-{0xa8, 0xa8, 265},
-{0xa9, 0xa9, 266},
-{0xaa, 0xaa, 267},
-{0xab, 0xab, 268},
-{0xac, 0xac, 269},
-{0xaf, 0xaf, 270},
-{0xb0, 0xbf, 48},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9d, 48},
-{0x9e, 0x9e, 271},
-{0x9f, 0x9f, 272},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa4, 0xa8, 48},
-{0xa9, 0xa9, 273},
-{0xaa, 0xaa, 48},
-{0xab, 0xab, 274},
-{0xac, 0xac, 275},
-{0xad, 0xad, 276},
-{0xae, 0xae, 277},
-{0xaf, 0xaf, 278},
-{0xb0, 0xb3, 48},
-{0xb4, 0xb4, 279},
-{0xb5, 0xb5, 280},
-{0xb6, 0xb6, 281},
-{0xb7, 0xb7, 282},
-{0xb9, 0xb9, 283},
-{0xba, 0xba, 48},
-{0xbb, 0xbb, 284},
-{0xbc, 0xbc, 285},
-{0xbd, 0xbd, 286},
-{0xbe, 0xbe, 287},
-{0xbf, 0xbf, 288},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 289},
-{0x91, 0x91, 290},
-{0x92, 0x92, 291},
-{0x93, 0x93, 292},
-{0x96, 0x96, 293},
-{0x9b, 0x9b, 294},
-{0x9d, 0x9d, 295},
-{0x9e, 0x9e, 296},
-{0xa0, 0xa9, 70},
-{0xaa, 0xaa, 297},
-{0xab, 0xab, 298},
-{0xaf, 0xaf, 299},
-
-}},
-{
-Label: 46,
-},
-{
-Label: 96,
-},
-{
-Table: dfa.TransTable{
-{0x22, 0x22, 6},
-{0x27, 0x27, 6},
-{0x30, 0x37, 300},
-{0x55, 0x55, 301},
-{0x5c, 0x5c, 6},
-{0x61, 0x62, 6},
-{0x66, 0x66, 6},
-{0x6e, 0x6e, 6},
-{0x72, 0x72, 6},
-{0x74, 0x74, 6},
-{0x75, 0x75, 302},
-{0x76, 0x76, 6},
-{0x78, 0x78, 303},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 6},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 79},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 79},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 79},
-{0xbb, 0xbb, 304},
-{0xbc, 0xbf, 79},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 81},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 81},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 81},
-}},
-{
-Label: 29,
-},
-{
-Label: 36,
-},
-{
-Label: 30,
-},
-{
-Label: 24,
-Table: dfa.TransTable{{0x3d, 0x3d, 305},
-}},
-{
-Table: dfa.TransTable{{0x27, 0x27, 306},
-}},
-{
-Table: dfa.TransTable{
-{0x22, 0x22, 90},
-{0x27, 0x27, 90},
-{0x30, 0x37, 307},
-{0x55, 0x55, 308},
-{0x5c, 0x5c, 90},
-{0x61, 0x62, 90},
-{0x66, 0x66, 90},
-{0x6e, 0x6e, 90},
-{0x72, 0x72, 90},
-{0x74, 0x74, 90},
-{0x75, 0x75, 309},
-{0x76, 0x76, 90},
-{0x78, 0x78, 310},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 90},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 92},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 92},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 92},
-{0xbb, 0xbb, 311},
-{0xbc, 0xbf, 92},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 94},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 94},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 94},
-}},
-{
-Label: 27,
-},
-{
-Label: 39,
-},
-{
-Label: 25,
-},
-{
-Label: 40,
-},
-{
-Label: 26,
-},
-{
-Table: dfa.TransTable{{0x2e, 0x2e, 312},
-}},
-{
-Label: 8,
-Table: dfa.TransTable{
-{0x30, 0x39, 105},
-{0x45, 0x45, 313},
-{0x65, 0x65, 313},
-{0x69, 0x69, 114},
-
-}},
-{
-Label: 109,
-Table: dfa.TransTable{
-{0x01, 0x09, 106},
-{0x0a, 0x0a, 314},
-{0x0b, 0x29, 106},
-{0x2a, 0x2a, 315},
-{0x2b, 0x7f, 106},
-{0xc2, 0xdf, 316},
-{0xe0, 0xe0, 317},
-{0xe1, 0xee, 318},
-{0xef, 0xef, 319},
-{0xf0, 0xf0, 320},
-{0xf1, 0xf3, 321},
-{0xf4, 0xf4, 322},
-
-}},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 323},
-{0x0a, 0x0a, 324},
-{0x0b, 0x6b, 323},
-{0x6c, 0x6c, 325},
-{0x6d, 0x7f, 323},
-{0xc2, 0xdf, 326},
-{0xe0, 0xe0, 327},
-{0xe1, 0xee, 328},
-{0xef, 0xef, 329},
-{0xf0, 0xf0, 330},
-{0xf1, 0xf3, 331},
-{0xf4, 0xf4, 332},
-
-}},
-{
-Label: 28,
-},
-{
-Label: 8,
-Table: dfa.TransTable{
-{0x30, 0x39, 109},
-{0x45, 0x45, 333},
-{0x65, 0x65, 333},
-{0x69, 0x69, 114},
-
-}},
-{
-Label: 7,
-Table: dfa.TransTable{
-{0x2e, 0x2e, 109},
-{0x30, 0x37, 110},
-{0x38, 0x39, 111},
-{0x45, 0x45, 112},
-{0x65, 0x65, 112},
-{0x69, 0x69, 114},
-
-}},
-{
-Label: 114,
-Table: dfa.TransTable{
-{0x2e, 0x2e, 109},
-{0x30, 0x39, 111},
-{0x45, 0x45, 112},
-{0x65, 0x65, 112},
-{0x69, 0x69, 114},
-
-}},
-{
-Table: dfa.TransTable{
-{0x2b, 0x2b, 334},
-{0x2d, 0x2d, 334},
-{0x30, 0x39, 335},
-
-}},
-{
-Label: 108,
-Table: dfa.TransTable{
-{0x30, 0x39, 336},
-{0x41, 0x46, 336},
-{0x61, 0x66, 336},
-
-}},
-{
-Label: 9,
-},
-{
-Label: 49,
-},
-{
-Label: 38,
-},
-{
-Label: 22,
-Table: dfa.TransTable{{0x3d, 0x3d, 337},
-}},
-{
-Label: 47,
-},
-{
-Label: 41,
-},
-{
-Label: 48,
-},
-{
-Label: 23,
-Table: dfa.TransTable{{0x3d, 0x3d, 338},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8a, 25},
-{0xa0, 0xa9, 25},
-{0xae, 0xaf, 25},
-{0xb1, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x93, 25},
-{0x95, 0x95, 25},
-{0xa5, 0xa6, 25},
-{0xae, 0xbc, 25},
-{0xbf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xaa, 25},
-{0xb4, 0xb5, 25},
-{0xba, 0xba, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa0, 0xa0, 163},
-{0xa1, 0xa1, 164},
-{0xa2, 0xa2, 165},
-{0xa4, 0xa4, 166},
-{0xa5, 0xa5, 339},
-{0xa6, 0xa6, 168},
-{0xa7, 0xa7, 340},
-{0xa8, 0xa8, 170},
-{0xa9, 0xa9, 341},
-{0xaa, 0xaa, 172},
-{0xab, 0xab, 342},
-{0xac, 0xac, 174},
-{0xad, 0xad, 343},
-{0xae, 0xae, 176},
-{0xaf, 0xaf, 344},
-{0xb0, 0xb0, 178},
-{0xb1, 0xb1, 345},
-{0xb2, 0xb2, 178},
-{0xb3, 0xb3, 346},
-{0xb4, 0xb4, 181},
-{0xb5, 0xb5, 347},
-{0xb6, 0xb6, 183},
-{0xb7, 0xb7, 348},
-{0xb8, 0xb8, 185},
-{0xb9, 0xb9, 349},
-{0xba, 0xba, 186},
-{0xbb, 0xbb, 350},
-{0xbc, 0xbc, 351},
-{0xbd, 0xbd, 189},
-{0xbe, 0xbe, 190},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 191},
-{0x81, 0x81, 352},
-{0x82, 0x82, 353},
-{0x83, 0x83, 194},
-{0x84, 0x88, 48},
-{0x89, 0x89, 195},
-{0x8a, 0x8a, 196},
-{0x8b, 0x8b, 197},
-{0x8c, 0x8c, 198},
-{0x8d, 0x8d, 199},
-{0x8e, 0x8e, 200},
-{0x8f, 0x8f, 201},
-{0x90, 0x90, 202},
-{0x91, 0x98, 48},
-{0x99, 0x99, 203},
-{0x9a, 0x9a, 204},
-{0x9b, 0x9b, 205},
-{0x9c, 0x9c, 206},
-{0x9d, 0x9d, 207},
-{0x9e, 0x9e, 208},
-{0x9f, 0x9f, 354},
-{0xa0, 0xa0, 355},
-{0xa1, 0xa1, 210},
-{0xa2, 0xa2, 211},
-{0xa3, 0xa3, 212},
-{0xa4, 0xa4, 213},
-{0xa5, 0xa5, 356},
-{0xa6, 0xa6, 215},
-{0xa7, 0xa7, 357},
-{0xa8, 0xa8, 217},
-{0xa9, 0xa9, 218},
-{0xaa, 0xaa, 358},
-{0xac, 0xac, 220},
-{0xad, 0xad, 359},
-{0xae, 0xae, 360},
-{0xaf, 0xaf, 223},
-{0xb0, 0xb0, 224},
-{0xb1, 0xb1, 361},
-{0xb3, 0xb3, 226},
-{0xb4, 0xb6, 48},
-{0xb8, 0xbb, 48},
-{0xbc, 0xbc, 227},
-{0xbd, 0xbd, 228},
-{0xbe, 0xbe, 229},
-{0xbf, 0xbf, 230},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x91, 48},
-{0x92, 0x92, 250},
-{0x93, 0x93, 251},
-{0x94, 0x97, 48},
-{0x98, 0x98, 362},
-{0x99, 0x99, 253},
-{0x9a, 0x9a, 254},
-{0x9b, 0x9b, 223},
-{0x9c, 0x9c, 255},
-{0x9d, 0x9d, 48},
-{0x9e, 0x9e, 256},
-{0x9f, 0x9f, 257},
-{0xa0, 0xa0, 258},
-{0xa1, 0xa1, 208},
-{0xa2, 0xa2, 259},
-{0xa3, 0xa3, 363},
-{0xa4, 0xa4, 364},
-{0xa5, 0xa5, 262},
-{0xa6, 0xa6, 263},
-{0xa7, 0xa7, 365},
-{0xa8, 0xa8, 265},
-{0xa9, 0xa9, 366},
-{0xaa, 0xaa, 267},
-{0xab, 0xab, 268},
-{0xac, 0xac, 269},
-{0xaf, 0xaf, 367},
-{0xb0, 0xbf, 48},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa4, 0xa8, 48},
-{0xa9, 0xa9, 273},
-{0xaa, 0xaa, 48},
-{0xab, 0xab, 274},
-{0xac, 0xac, 275},
-{0xad, 0xad, 276},
-{0xae, 0xae, 277},
-{0xaf, 0xaf, 278},
-{0xb0, 0xb3, 48},
-{0xb4, 0xb4, 279},
-{0xb5, 0xb5, 280},
-{0xb6, 0xb6, 281},
-{0xb7, 0xb7, 282},
-{0xb9, 0xb9, 283},
-{0xba, 0xba, 48},
-{0xbb, 0xbb, 284},
-{0xbc, 0xbc, 368},
-{0xbd, 0xbd, 286},
-{0xbe, 0xbe, 287},
-{0xbf, 0xbf, 288},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 369},
-{0x91, 0x91, 370},
-{0x92, 0x92, 291},
-{0x93, 0x93, 292},
-{0x96, 0x96, 371},
-{0x9b, 0x9b, 294},
-{0x9d, 0x9d, 372},
-{0x9e, 0x9e, 373},
-{0x9f, 0x9f, 374},
-{0xa0, 0xa9, 70},
-{0xaa, 0xaa, 297},
-{0xab, 0xab, 298},
-{0xaf, 0xaf, 299},
-
-}},
-{
-Label: 32,
-},
-{
-Label: 95,
-},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 29},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 132},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 132},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 132},
-{0xbb, 0xbb, 375},
-{0xbc, 0xbf, 132},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 134},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 134},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 134},
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 376},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x72, 25},
-{0x73, 0x73, 377},
-{0x74, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 378},
-{0x62, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6d, 25},
-{0x6e, 0x6e, 379},
-{0x6f, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x65, 25},
-{0x66, 0x66, 380},
-{0x67, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x72, 25},
-{0x73, 0x73, 381},
-{0x74, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6b, 25},
-{0x6c, 0x6c, 382},
-{0x6d, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 383},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6d, 25},
-{0x6e, 0x6e, 384},
-{0x6f, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 74,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 385},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 76,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6f, 25},
-{0x70, 0x70, 386},
-{0x71, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 387},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6f, 25},
-{0x70, 0x70, 388},
-{0x71, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x62, 25},
-{0x63, 0x63, 389},
-{0x64, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6d, 25},
-{0x6e, 0x6e, 390},
-{0x6f, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 391},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6b, 25},
-{0x6c, 0x6c, 392},
-{0x6d, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 393},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x68, 25},
-{0x69, 0x69, 394},
-{0x6a, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6f, 25},
-{0x70, 0x70, 395},
-{0x71, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 396},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 31,
-},
-{
-Label: 37,
-},
-{
-Table: dfa.TransTable{
-{0x80, 0x95, 25},
-{0x9a, 0x9a, 25},
-{0xa4, 0xa4, 25},
-{0xa8, 0xa8, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x98, 25},
-}},
-{
-Table: dfa.TransTable{
-{0xa0, 0xa0, 25},
-{0xa2, 0xac, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x84, 0xb9, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 25},
-{0x98, 0xa1, 25},
-{0xb1, 0xb7, 25},
-{0xb9, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x8c, 25},
-{0x8f, 0x90, 25},
-{0x93, 0xa8, 25},
-{0xaa, 0xb0, 25},
-{0xb2, 0xb2, 25},
-{0xb6, 0xb9, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x8e, 0x8e, 25},
-{0x9c, 0x9d, 25},
-{0x9f, 0xa1, 25},
-{0xb0, 0xb1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x8a, 25},
-{0x8f, 0x90, 25},
-{0x93, 0xa8, 25},
-{0xaa, 0xb0, 25},
-{0xb2, 0xb3, 25},
-{0xb5, 0xb6, 25},
-{0xb8, 0xb9, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x99, 0x9c, 25},
-{0x9e, 0x9e, 25},
-{0xb2, 0xb4, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x8d, 25},
-{0x8f, 0x91, 25},
-{0x93, 0xa8, 25},
-{0xaa, 0xb0, 25},
-{0xb2, 0xb3, 25},
-{0xb5, 0xb9, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 25},
-{0xa0, 0xa1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x8c, 25},
-{0x8f, 0x90, 25},
-{0x93, 0xa8, 25},
-{0xaa, 0xb0, 25},
-{0xb2, 0xb3, 25},
-{0xb5, 0xb9, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x9c, 0x9d, 25},
-{0x9f, 0xa1, 25},
-{0xb1, 0xb1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x83, 0x83, 25},
-{0x85, 0x8a, 25},
-{0x8e, 0x90, 25},
-{0x92, 0x95, 25},
-{0x99, 0x9a, 25},
-{0x9c, 0x9c, 25},
-{0x9e, 0x9f, 25},
-{0xa3, 0xa4, 25},
-{0xa8, 0xaa, 25},
-{0xae, 0xb9, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0x90, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x8c, 25},
-{0x8e, 0x90, 25},
-{0x92, 0xa8, 25},
-{0xaa, 0xb3, 25},
-{0xb5, 0xb9, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x98, 0x99, 25},
-{0xa0, 0xa1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x9e, 0x9e, 25},
-{0xa0, 0xa1, 25},
-{0xb1, 0xb2, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x8c, 25},
-{0x8e, 0x90, 25},
-{0x92, 0xba, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x8e, 0x8e, 25},
-{0xa0, 0xa1, 25},
-{0xba, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x96, 25},
-{0x9a, 0xb1, 25},
-{0xb3, 0xbb, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x86, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0xb0, 25},
-{0xb2, 0xb3, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0x82, 25},
-{0x84, 0x84, 25},
-{0x87, 0x88, 25},
-{0x8a, 0x8a, 25},
-{0x8d, 0x8d, 25},
-{0x94, 0x97, 25},
-{0x99, 0x9f, 25},
-{0xa1, 0xa3, 25},
-{0xa5, 0xa5, 25},
-{0xa7, 0xa7, 25},
-{0xaa, 0xab, 25},
-{0xad, 0xb0, 25},
-{0xb2, 0xb3, 25},
-{0xbd, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x84, 25},
-{0x86, 0x86, 25},
-{0x9c, 0x9f, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x80, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x87, 25},
-{0x89, 0xac, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x88, 0x8c, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xaa, 25},
-{0xbf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x95, 25},
-{0x9a, 0x9d, 25},
-{0xa1, 0xa1, 25},
-{0xa5, 0xa6, 25},
-{0xae, 0xb0, 25},
-{0xb5, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x81, 25},
-{0x8e, 0x8e, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x85, 25},
-{0x87, 0x87, 25},
-{0x8d, 0x8d, 25},
-{0x90, 0xba, 25},
-{0xbc, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x88, 25},
-{0x8a, 0x8d, 25},
-{0x90, 0x96, 25},
-{0x98, 0x98, 25},
-{0x9a, 0x9d, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x88, 25},
-{0x8a, 0x8d, 25},
-{0x90, 0xb0, 25},
-{0xb2, 0xb5, 25},
-{0xb8, 0xbe, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 25},
-{0x82, 0x85, 25},
-{0x88, 0x96, 25},
-{0x98, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x90, 25},
-{0x92, 0x95, 25},
-{0x98, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x9a, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8f, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xb4, 25},
-}},
-{
-Table: dfa.TransTable{{0x81, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xac, 25},
-{0xaf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0x9a, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xaa, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8c, 25},
-{0x8e, 0x91, 25},
-{0xa0, 0xb1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x91, 25},
-{0xa0, 0xac, 25},
-{0xae, 0xb0, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xb3, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x97, 0x97, 25},
-{0x9c, 0x9c, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xb7, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa8, 25},
-{0xaa, 0xaa, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xb5, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x9c, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0xad, 25},
-{0xb0, 0xb4, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xab, 25},
-}},
-{
-Table: dfa.TransTable{{0x81, 0x87, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x96, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x94, 25},
-}},
-{
-Table: dfa.TransTable{{0xa7, 0xa7, 25},
-}},
-{
-Table: dfa.TransTable{{0x85, 0xb3, 25},
-}},
-{
-Table: dfa.TransTable{{0x85, 0x8b, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x83, 0xa0, 25},
-{0xae, 0xaf, 25},
-{0xba, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xa5, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xa3, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x8d, 0x8f, 25},
-{0x9a, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa9, 0xac, 25},
-{0xae, 0xb1, 25},
-{0xb5, 0xb6, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x95, 25},
-{0x98, 0x9d, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x85, 25},
-{0x88, 0x8d, 25},
-{0x90, 0x97, 25},
-{0x99, 0x99, 25},
-{0x9b, 0x9b, 25},
-{0x9d, 0x9d, 25},
-{0x9f, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xb4, 25},
-{0xb6, 0xbc, 25},
-{0xbe, 0xbe, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x82, 0x84, 25},
-{0x86, 0x8c, 25},
-{0x90, 0x93, 25},
-{0x96, 0x9b, 25},
-{0xa0, 0xac, 25},
-{0xb2, 0xb4, 25},
-{0xb6, 0xbc, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0xb1, 0xb1, 25},
-{0xbf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0x9c, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x82, 0x82, 25},
-{0x87, 0x87, 25},
-{0x8a, 0x93, 25},
-{0x95, 0x95, 25},
-{0x99, 0x9d, 25},
-{0xa4, 0xa4, 25},
-{0xa6, 0xa6, 25},
-{0xa8, 0xa8, 25},
-{0xaa, 0xad, 25},
-{0xaf, 0xb9, 25},
-{0xbc, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x89, 25},
-{0x8e, 0x8e, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x83, 0x84, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xae, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9e, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa4, 25},
-{0xab, 0xae, 25},
-{0xb2, 0xb3, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa5, 25},
-{0xa7, 0xa7, 25},
-{0xad, 0xad, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa7, 25},
-{0xaf, 0xaf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x96, 25},
-{0xa0, 0xa6, 25},
-{0xa8, 0xae, 25},
-{0xb0, 0xb6, 25},
-{0xb8, 0xbe, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x86, 25},
-{0x88, 0x8e, 25},
-{0x90, 0x96, 25},
-{0x98, 0x9e, 25},
-
-}},
-{
-Table: dfa.TransTable{{0xaf, 0xaf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x86, 25},
-{0xb1, 0xb5, 25},
-{0xbb, 0xbc, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x96, 25},
-{0x9d, 0x9f, 25},
-{0xa1, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 25},
-{0xbc, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0xad, 25},
-{0xb1, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8e, 25},
-{0xa0, 0xba, 25},
-
-}},
-{
-Table: dfa.TransTable{{0xb0, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8c, 25},
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbd, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8c, 25},
-{0x90, 0x9f, 25},
-{0xaa, 0xab, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xae, 25},
-{0xbf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x97, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x97, 0x9f, 25},
-{0xa2, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x88, 25},
-{0x8b, 0x8e, 25},
-{0x90, 0x93, 25},
-{0xa0, 0xaa, 25},
-
-}},
-{
-Table: dfa.TransTable{{0xb8, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x81, 25},
-{0x83, 0x85, 25},
-{0x87, 0x8a, 25},
-{0x8c, 0xa2, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x82, 0xb3, 25},
-}},
-{
-Table: dfa.TransTable{
-{0xb2, 0xb7, 25},
-{0xbb, 0xbb, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x8a, 0xa5, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x86, 25},
-{0xa0, 0xbc, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x84, 0xb2, 25},
-}},
-{
-Table: dfa.TransTable{{0x8f, 0x8f, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xa8, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x82, 25},
-{0x84, 0x8b, 25},
-{0xa0, 0xb6, 25},
-{0xba, 0xba, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xaf, 25},
-{0xb1, 0xb1, 25},
-{0xb5, 0xb6, 25},
-{0xb9, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 25},
-{0x82, 0x82, 25},
-{0x9b, 0x9d, 25},
-{0xa0, 0xaa, 25},
-{0xb2, 0xb4, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0x86, 25},
-{0x89, 0x8e, 25},
-{0x91, 0x96, 25},
-{0xa0, 0xa6, 25},
-{0xa8, 0xae, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xa2, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa3, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x86, 25},
-{0x8b, 0xbb, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xad, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x99, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x86, 25},
-{0x93, 0x97, 25},
-{0x9d, 0x9d, 25},
-{0x9f, 0xa8, 25},
-{0xaa, 0xb6, 25},
-{0xb8, 0xbc, 25},
-{0xbe, 0xbe, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x81, 25},
-{0x83, 0x84, 25},
-{0x86, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xb1, 25},
-}},
-{
-Table: dfa.TransTable{{0x93, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbd, 25},
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8f, 25},
-{0x92, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x87, 25},
-{0xb0, 0xbb, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0xb0, 0xb4, 25},
-{0xb6, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbc, 25},
-}},
-{
-Table: dfa.TransTable{{0xa1, 0xba, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0x9a, 25},
-{0xa6, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x82, 0x87, 25},
-{0x8a, 0x8f, 25},
-{0x92, 0x97, 25},
-{0x9a, 0x9c, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 397},
-{0x81, 0x81, 398},
-{0x82, 0x82, 48},
-{0x83, 0x83, 399},
-{0x8a, 0x8a, 400},
-{0x8b, 0x8b, 401},
-{0x8c, 0x8c, 402},
-{0x8d, 0x8d, 403},
-{0x8e, 0x8e, 404},
-{0x8f, 0x8f, 405},
-{0x90, 0x91, 48},
-{0x92, 0x92, 406},
-{0xa0, 0xa0, 407},
-{0xa1, 0xa1, 408},
-{0xa4, 0xa4, 409},
-{0xa6, 0xa6, 410},
-{0xa8, 0xa8, 411},
-{0xa9, 0xa9, 412},
-{0xac, 0xac, 212},
-{0xad, 0xad, 413},
-{0xb0, 0xb0, 48},
-{0xb1, 0xb1, 414},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 415},
-{0x82, 0x82, 416},
-{0x83, 0x83, 417},
-{0x84, 0x84, 418},
-{0x86, 0x86, 419},
-{0x87, 0x87, 420},
-{0x9a, 0x9a, 205},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8c, 48},
-{0x8d, 0x8d, 421},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8f, 48},
-{0x90, 0x90, 421},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa0, 0xa7, 48},
-{0xa8, 0xa8, 422},
-{0xbc, 0xbc, 48},
-{0xbd, 0xbd, 423},
-{0xbe, 0xbe, 424},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x80, 425},
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 48},
-{0x91, 0x91, 426},
-{0x92, 0x92, 427},
-{0x93, 0x93, 428},
-{0x94, 0x94, 429},
-{0x95, 0x95, 430},
-{0x96, 0x99, 48},
-{0x9a, 0x9a, 431},
-{0x9b, 0x9b, 432},
-{0x9c, 0x9c, 433},
-{0x9d, 0x9d, 434},
-{0x9e, 0x9e, 435},
-{0x9f, 0x9f, 436},
-
-}},
-{
-Table: dfa.TransTable{
-{0xb8, 0xb8, 437},
-{0xb9, 0xb9, 438},
-{0xba, 0xba, 439},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9a, 48},
-{0x9b, 0x9b, 440},
-{0x9c, 0xbf, 48},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9b, 48},
-{0x9c, 0x9c, 201},
-{0x9d, 0x9f, 48},
-{0xa0, 0xa0, 406},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa0, 0xa7, 48},
-{0xa8, 0xa8, 406},
-
-}},
-{
-Table: dfa.TransTable{{0x30, 0x37, 441},
-}},
-{
-Table: dfa.TransTable{{0x30, 0x30, 442},
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 443},
-{0x41, 0x46, 443},
-{0x61, 0x66, 443},
-
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 444},
-{0x41, 0x46, 444},
-{0x61, 0x66, 444},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 6},
-}},
-{
-Label: 35,
-},
-{
-Label: 10,
-},
-{
-Table: dfa.TransTable{{0x30, 0x37, 445},
-}},
-{
-Table: dfa.TransTable{{0x30, 0x30, 446},
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 447},
-{0x41, 0x46, 447},
-{0x61, 0x66, 447},
-
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 448},
-{0x41, 0x46, 448},
-{0x61, 0x66, 448},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 90},
-}},
-{
-Label: 50,
-},
-{
-Table: dfa.TransTable{
-{0x2b, 0x2b, 449},
-{0x2d, 0x2d, 449},
-{0x30, 0x39, 450},
-
-}},
-{
-Label: 109,
-Table: dfa.TransTable{
-{0x01, 0x29, 314},
-{0x2a, 0x2a, 451},
-{0x2b, 0x7f, 314},
-{0xc2, 0xdf, 452},
-{0xe0, 0xe0, 453},
-{0xe1, 0xee, 454},
-{0xef, 0xef, 455},
-{0xf0, 0xf0, 456},
-{0xf1, 0xf3, 457},
-{0xf4, 0xf4, 458},
-
-}},
-{
-Label: 109,
-Table: dfa.TransTable{
-{0x01, 0x09, 106},
-{0x0a, 0x0a, 314},
-{0x0b, 0x29, 106},
-{0x2a, 0x2a, 315},
-{0x2b, 0x2e, 106},
-{0x2f, 0x2f, 459},
-{0x30, 0x7f, 106},
-{0xc2, 0xdf, 316},
-{0xe0, 0xe0, 317},
-{0xe1, 0xee, 318},
-{0xef, 0xef, 319},
-{0xf0, 0xf0, 320},
-{0xf1, 0xf3, 321},
-{0xf4, 0xf4, 322},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 106},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 316},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 316},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 316},
-{0xbb, 0xbb, 460},
-{0xbc, 0xbf, 316},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 318},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 318},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 318},
-}},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 323},
-{0x0a, 0x0a, 324},
-{0x0b, 0x7f, 323},
-{0xc2, 0xdf, 326},
-{0xe0, 0xe0, 327},
-{0xe1, 0xee, 328},
-{0xef, 0xef, 329},
-{0xf0, 0xf0, 330},
-{0xf1, 0xf3, 331},
-{0xf4, 0xf4, 332},
-
-}},
-{
-Label: 90,
-},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 323},
-{0x0a, 0x0a, 324},
-{0x0b, 0x68, 323},
-{0x69, 0x69, 461},
-{0x6a, 0x7f, 323},
-{0xc2, 0xdf, 326},
-{0xe0, 0xe0, 327},
-{0xe1, 0xee, 328},
-{0xef, 0xef, 329},
-{0xf0, 0xf0, 330},
-{0xf1, 0xf3, 331},
-{0xf4, 0xf4, 332},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 323},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 326},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 326},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 326},
-{0xbb, 0xbb, 462},
-{0xbc, 0xbf, 326},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 328},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 328},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 328},
-}},
-{
-Table: dfa.TransTable{
-{0x2b, 0x2b, 463},
-{0x2d, 0x2d, 463},
-{0x30, 0x39, 464},
-
-}},
-{
-Table: dfa.TransTable{{0x30, 0x39, 335},
-}},
-{
-Label: 8,
-Table: dfa.TransTable{
-{0x30, 0x39, 335},
-{0x69, 0x69, 114},
-
-}},
-{
-Label: 7,
-Table: dfa.TransTable{
-{0x30, 0x39, 336},
-{0x41, 0x46, 336},
-{0x61, 0x66, 336},
-
-}},
-{
-Label: 33,
-},
-{
-Label: 34,
-},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 25},
-{0x98, 0xa1, 25},
-{0xa6, 0xaf, 25},
-{0xb1, 0xb7, 25},
-{0xb9, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x8e, 0x8e, 25},
-{0x9c, 0x9d, 25},
-{0x9f, 0xa1, 25},
-{0xa6, 0xb1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x99, 0x9c, 25},
-{0x9e, 0x9e, 25},
-{0xa6, 0xaf, 25},
-{0xb2, 0xb4, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 25},
-{0xa0, 0xa1, 25},
-{0xa6, 0xaf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x9c, 0x9d, 25},
-{0x9f, 0xa1, 25},
-{0xa6, 0xaf, 25},
-{0xb1, 0xb1, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 25},
-{0xa6, 0xaf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x98, 0x99, 25},
-{0xa0, 0xa1, 25},
-{0xa6, 0xaf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x9e, 0x9e, 25},
-{0xa0, 0xa1, 25},
-{0xa6, 0xaf, 25},
-{0xb1, 0xb2, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x8e, 0x8e, 25},
-{0xa0, 0xa1, 25},
-{0xa6, 0xaf, 25},
-{0xba, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x86, 25},
-{0xa6, 0xaf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x86, 25},
-{0x90, 0x99, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x84, 25},
-{0x86, 0x86, 25},
-{0x90, 0x99, 25},
-{0x9c, 0x9f, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 25},
-{0xa0, 0xa9, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x89, 25},
-{0x90, 0x95, 25},
-{0x9a, 0x9d, 25},
-{0xa1, 0xa1, 25},
-{0xa5, 0xa6, 25},
-{0xae, 0xb0, 25},
-{0xb5, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x81, 25},
-{0x8e, 0x8e, 25},
-{0x90, 0x99, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x97, 0x97, 25},
-{0x9c, 0x9c, 25},
-{0xa0, 0xa9, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x99, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x86, 0xad, 25},
-{0xb0, 0xb4, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0x87, 25},
-{0x90, 0x99, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x89, 25},
-{0x90, 0x99, 25},
-{0xa7, 0xa7, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x8b, 25},
-{0x90, 0x99, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x83, 0xa0, 25},
-{0xae, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x89, 25},
-{0x8d, 0xbd, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8c, 25},
-{0x90, 0xab, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x99, 25},
-{0xb2, 0xb7, 25},
-{0xbb, 0xbb, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa5, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x8f, 0x99, 25},
-{0xb0, 0xb9, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x82, 25},
-{0x84, 0x8b, 25},
-{0x90, 0x99, 25},
-{0xa0, 0xb6, 25},
-{0xba, 0xba, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa2, 25},
-{0xb0, 0xb9, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x99, 25},
-{0xa1, 0xba, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 397},
-{0x81, 0x81, 398},
-{0x82, 0x82, 48},
-{0x83, 0x83, 399},
-{0x8a, 0x8a, 400},
-{0x8b, 0x8b, 401},
-{0x8c, 0x8c, 402},
-{0x8d, 0x8d, 403},
-{0x8e, 0x8e, 404},
-{0x8f, 0x8f, 405},
-{0x90, 0x91, 48},
-{0x92, 0x92, 465},
-{0xa0, 0xa0, 407},
-{0xa1, 0xa1, 408},
-{0xa4, 0xa4, 409},
-{0xa6, 0xa6, 410},
-{0xa8, 0xa8, 411},
-{0xa9, 0xa9, 412},
-{0xac, 0xac, 212},
-{0xad, 0xad, 413},
-{0xb0, 0xb0, 48},
-{0xb1, 0xb1, 414},
-{0xb4, 0xb4, 466},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 415},
-{0x81, 0x81, 467},
-{0x82, 0x82, 416},
-{0x83, 0x83, 468},
-{0x84, 0x84, 469},
-{0x86, 0x86, 419},
-{0x87, 0x87, 470},
-{0x8b, 0x8b, 466},
-{0x91, 0x91, 471},
-{0x93, 0x93, 471},
-{0x99, 0x99, 471},
-{0x9a, 0x9a, 205},
-{0x9b, 0x9b, 472},
-{0x9c, 0x9c, 466},
-{0xa3, 0xa3, 473},
-{0xa5, 0xa5, 471},
-{0xb1, 0xb1, 471},
-{0xb5, 0xb5, 471},
-{0xb6, 0xb6, 473},
-
-}},
-{
-Table: dfa.TransTable{
-{0xa0, 0xa7, 48},
-{0xa8, 0xa8, 422},
-{0xa9, 0xa9, 473},
-{0xad, 0xad, 471},
-{0xbc, 0xbc, 48},
-{0xbd, 0xbd, 423},
-{0xbe, 0xbe, 424},
-
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0x90, 48},
-{0x91, 0x91, 426},
-{0x92, 0x92, 427},
-{0x93, 0x93, 428},
-{0x94, 0x94, 429},
-{0x95, 0x95, 430},
-{0x96, 0x99, 48},
-{0x9a, 0x9a, 431},
-{0x9b, 0x9b, 432},
-{0x9c, 0x9c, 433},
-{0x9d, 0x9d, 434},
-{0x9e, 0x9e, 435},
-{0x9f, 0x9f, 474},
-
-}},
-{
-Table: dfa.TransTable{
-{0x85, 0x85, 472},
-{0x8b, 0x8b, 466},
-{0xa5, 0xa5, 471},
-{0xb8, 0xb8, 437},
-{0xb9, 0xb9, 438},
-{0xba, 0xba, 439},
-
-}},
-{
-Table: dfa.TransTable{{0xaf, 0xaf, 466},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 29},
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 475},
-{0x62, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 476},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6d, 25},
-{0x6e, 0x6e, 477},
-{0x6f, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x72, 25},
-{0x73, 0x73, 478},
-{0x74, 0x74, 479},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 480},
-{0x62, 0x64, 25},
-{0x65, 0x65, 481},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 482},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6b, 25},
-{0x6c, 0x6c, 483},
-{0x6d, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 72,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x62, 25},
-{0x63, 0x63, 484},
-{0x64, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6e, 25},
-{0x6f, 0x6f, 485},
-{0x70, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6e, 25},
-{0x6f, 0x6f, 486},
-{0x70, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 487},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 79,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6a, 25},
-{0x6b, 0x6b, 488},
-{0x6c, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x66, 25},
-{0x67, 0x67, 489},
-{0x68, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x74, 25},
-{0x75, 0x75, 490},
-{0x76, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 491},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x74, 25},
-{0x75, 0x75, 492},
-{0x76, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 493},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 494},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 87,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8b, 25},
-{0x8d, 0xa6, 25},
-{0xa8, 0xba, 25},
-{0xbc, 0xbd, 25},
-{0xbf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8d, 25},
-{0x90, 0x9d, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xba, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9c, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x90, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9e, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 25},
-{0x82, 0x89, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9d, 25},
-{0xa0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x83, 25},
-{0x88, 0x8f, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x9d, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x85, 25},
-{0x88, 0x88, 25},
-{0x8a, 0xb5, 25},
-{0xb7, 0xb8, 25},
-{0xbc, 0xbc, 25},
-{0xbf, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x95, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x95, 25},
-{0xa0, 0xb9, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xb7, 25},
-{0xbe, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 25},
-{0x90, 0x93, 25},
-{0x95, 0x97, 25},
-{0x99, 0xb3, 25},
-
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbc, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x95, 25},
-{0xa0, 0xb2, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x88, 25},
-}},
-{
-Table: dfa.TransTable{{0x83, 0xb7, 25},
-}},
-{
-Table: dfa.TransTable{{0x83, 0xaf, 25},
-}},
-{
-Table: dfa.TransTable{{0x90, 0xa8, 25},
-}},
-{
-Table: dfa.TransTable{{0x83, 0xa6, 25},
-}},
-{
-Table: dfa.TransTable{{0x83, 0xb2, 25},
-}},
-{
-Table: dfa.TransTable{{0x81, 0x84, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xae, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xb8, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x84, 25},
-{0x90, 0x90, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x93, 0x9f, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x81, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x94, 25},
-{0x96, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9c, 25},
-{0x9e, 0x9f, 25},
-{0xa2, 0xa2, 25},
-{0xa5, 0xa6, 25},
-{0xa9, 0xac, 25},
-{0xae, 0xb9, 25},
-{0xbb, 0xbb, 25},
-{0xbd, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x83, 25},
-{0x85, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x85, 25},
-{0x87, 0x8a, 25},
-{0x8d, 0x94, 25},
-{0x96, 0x9c, 25},
-{0x9e, 0xb9, 25},
-{0xbb, 0xbe, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x84, 25},
-{0x86, 0x86, 25},
-{0x8a, 0x90, 25},
-{0x92, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xa5, 25},
-{0xa8, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x80, 25},
-{0x82, 0x9a, 25},
-{0x9c, 0xba, 25},
-{0xbc, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x94, 25},
-{0x96, 0xb4, 25},
-{0xb6, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x8e, 25},
-{0x90, 0xae, 25},
-{0xb0, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x88, 25},
-{0x8a, 0xa8, 25},
-{0xaa, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x82, 25},
-{0x84, 0x8b, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x83, 25},
-{0x85, 0x9f, 25},
-{0xa1, 0xa2, 25},
-{0xa4, 0xa4, 25},
-{0xa7, 0xa7, 25},
-{0xa9, 0xb2, 25},
-{0xb4, 0xb7, 25},
-{0xb9, 0xb9, 25},
-{0xbb, 0xbb, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x82, 0x82, 25},
-{0x87, 0x87, 25},
-{0x89, 0x89, 25},
-{0x8b, 0x8b, 25},
-{0x8d, 0x8f, 25},
-{0x91, 0x92, 25},
-{0x94, 0x94, 25},
-{0x97, 0x97, 25},
-{0x99, 0x99, 25},
-{0x9b, 0x9b, 25},
-{0x9d, 0x9d, 25},
-{0x9f, 0x9f, 25},
-{0xa1, 0xa2, 25},
-{0xa4, 0xa4, 25},
-{0xa7, 0xaa, 25},
-{0xac, 0xb2, 25},
-{0xb4, 0xb7, 25},
-{0xb9, 0xbc, 25},
-{0xbe, 0xbe, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x89, 25},
-{0x8b, 0x9b, 25},
-{0xa1, 0xa3, 25},
-{0xa5, 0xa9, 25},
-{0xab, 0xbb, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0x96, 25},
-}},
-{
-Table: dfa.TransTable{{0x30, 0x37, 6},
-}},
-{
-Table: dfa.TransTable{{0x30, 0x30, 495},
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 303},
-{0x41, 0x46, 303},
-{0x61, 0x66, 303},
-
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 6},
-{0x41, 0x46, 6},
-{0x61, 0x66, 6},
-
-}},
-{
-Table: dfa.TransTable{{0x30, 0x37, 90},
-}},
-{
-Table: dfa.TransTable{{0x30, 0x30, 496},
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 310},
-{0x41, 0x46, 310},
-{0x61, 0x66, 310},
-
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 90},
-{0x41, 0x46, 90},
-{0x61, 0x66, 90},
-
-}},
-{
-Table: dfa.TransTable{{0x30, 0x39, 450},
-}},
-{
-Label: 8,
-Table: dfa.TransTable{
-{0x30, 0x39, 450},
-{0x69, 0x69, 114},
-
-}},
-{
-Label: 109,
-Table: dfa.TransTable{
-{0x01, 0x29, 314},
-{0x2a, 0x2a, 451},
-{0x2b, 0x2e, 314},
-{0x2f, 0x2f, 497},
-{0x30, 0x7f, 314},
-{0xc2, 0xdf, 452},
-{0xe0, 0xe0, 453},
-{0xe1, 0xee, 454},
-{0xef, 0xef, 455},
-{0xf0, 0xf0, 456},
-{0xf1, 0xf3, 457},
-{0xf4, 0xf4, 458},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 314},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 452},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 452},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 452},
-{0xbb, 0xbb, 498},
-{0xbc, 0xbf, 452},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 454},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 454},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 454},
-}},
-{
-Label: 93,
-},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 106},
-}},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 323},
-{0x0a, 0x0a, 324},
-{0x0b, 0x6d, 323},
-{0x6e, 0x6e, 499},
-{0x6f, 0x7f, 323},
-{0xc2, 0xdf, 326},
-{0xe0, 0xe0, 327},
-{0xe1, 0xee, 328},
-{0xef, 0xef, 329},
-{0xf0, 0xf0, 330},
-{0xf1, 0xf3, 331},
-{0xf4, 0xf4, 332},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 323},
-}},
-{
-Table: dfa.TransTable{{0x30, 0x39, 464},
-}},
-{
-Label: 8,
-Table: dfa.TransTable{
-{0x30, 0x39, 464},
-{0x69, 0x69, 114},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x9d, 25},
-{0xa0, 0xa9, 25},
-
-}},
-{
-Table: dfa.TransTable{{0xb0, 0xb9, 25},
-}},
-{
-Table: dfa.TransTable{{0xa6, 0xaf, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x90, 0xa8, 25},
-{0xb0, 0xb9, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x83, 0xa6, 25},
-{0xb6, 0xbf, 25},
-
-}},
-{
-Table: dfa.TransTable{
-{0x81, 0x84, 25},
-{0x90, 0x99, 25},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0x99, 25},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x89, 25},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xa9, 25},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0x82, 25},
-{0x84, 0x8b, 25},
-{0x8e, 0xbf, 25},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6a, 25},
-{0x6b, 0x6b, 500},
-{0x6c, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 64,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 65,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 501},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x68, 25},
-{0x69, 0x69, 502},
-{0x6a, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x74, 25},
-{0x75, 0x75, 503},
-{0x76, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 504},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 70,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 505},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 73,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 75,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 506},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 507},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 508},
-{0x62, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 509},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 510},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x62, 25},
-{0x63, 0x63, 511},
-{0x64, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x62, 25},
-{0x63, 0x63, 512},
-{0x64, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x62, 25},
-{0x63, 0x63, 513},
-{0x64, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 86,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x30, 514},
-{0x31, 0x31, 515},
-
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x30, 516},
-{0x31, 0x31, 517},
-
-}},
-{
-Label: 94,
-},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 314},
-}},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 323},
-{0x0a, 0x0a, 324},
-{0x0b, 0x64, 323},
-{0x65, 0x65, 518},
-{0x66, 0x7f, 323},
-{0xc2, 0xdf, 326},
-{0xe0, 0xe0, 327},
-{0xe1, 0xee, 328},
-{0xef, 0xef, 329},
-{0xf0, 0xf0, 330},
-{0xf1, 0xf3, 331},
-{0xf4, 0xf4, 332},
-
-}},
-{
-Label: 63,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 66,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6d, 25},
-{0x6e, 0x6e, 519},
-{0x6f, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6b, 25},
-{0x6c, 0x6c, 520},
-{0x6d, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 69,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x67, 25},
-{0x68, 0x68, 521},
-{0x69, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 522},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x65, 25},
-{0x66, 0x66, 523},
-{0x67, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x66, 25},
-{0x67, 0x67, 524},
-{0x68, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 81,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6d, 25},
-{0x6e, 0x6e, 525},
-{0x6f, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 526},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 527},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x67, 25},
-{0x68, 0x68, 528},
-{0x69, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 302},
-{0x41, 0x46, 302},
-{0x61, 0x66, 302},
-
-}},
-{
-Table: dfa.TransTable{{0x30, 0x30, 302},
-}},
-{
-Table: dfa.TransTable{
-{0x30, 0x39, 309},
-{0x41, 0x46, 309},
-{0x61, 0x66, 309},
-
-}},
-{
-Table: dfa.TransTable{{0x30, 0x30, 309},
-}},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 323},
-{0x0a, 0x0a, 324},
-{0x0b, 0x1f, 323},
-{0x20, 0x20, 529},
-{0x21, 0x7f, 323},
-{0xc2, 0xdf, 326},
-{0xe0, 0xe0, 327},
-{0xe1, 0xee, 328},
-{0xef, 0xef, 329},
-{0xf0, 0xf0, 330},
-{0xf1, 0xf3, 331},
-{0xf4, 0xf4, 332},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x74, 25},
-{0x75, 0x75, 530},
-{0x76, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x73, 25},
-{0x74, 0x74, 531},
-{0x75, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x71, 25},
-{0x72, 0x72, 532},
-{0x73, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 77,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x61, 533},
-{0x62, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 534},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 82,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 83,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 84,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 85,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 535},
-{0x0a, 0x0a, 324},
-{0x0b, 0x7f, 535},
-{0xc2, 0xdf, 536},
-{0xe0, 0xe0, 537},
-{0xe1, 0xee, 538},
-{0xef, 0xef, 539},
-{0xf0, 0xf0, 540},
-{0xf1, 0xf3, 541},
-{0xf4, 0xf4, 542},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 543},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 68,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x6e, 25},
-{0x6f, 0x6f, 544},
-{0x70, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x62, 25},
-{0x63, 0x63, 545},
-{0x64, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 80,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 91,
-Table: dfa.TransTable{
-{0x01, 0x09, 535},
-{0x0a, 0x0a, 546},
-{0x0b, 0x7f, 535},
-{0xc2, 0xdf, 536},
-{0xe0, 0xe0, 537},
-{0xe1, 0xee, 538},
-{0xef, 0xef, 539},
-{0xf0, 0xf0, 540},
-{0xf1, 0xf3, 541},
-{0xf4, 0xf4, 542},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 535},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 536},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 536},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 536},
-{0xbb, 0xbb, 547},
-{0xbc, 0xbf, 536},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 538},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 538},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 538},
-}},
-{
-Label: 67,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x74, 25},
-{0x75, 0x75, 548},
-{0x76, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x64, 25},
-{0x65, 0x65, 549},
-{0x66, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 92,
-},
-{
-Table: dfa.TransTable{{0x80, 0xbe, 535},
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x66, 25},
-{0x67, 0x67, 550},
-{0x68, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 78,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 6,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x67, 25},
-{0x68, 0x68, 551},
-{0x69, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-{
-Label: 71,
-Table: dfa.TransTable{
-{0x30, 0x39, 25},
-{0x41, 0x5a, 25},
-{0x5f, 0x5f, 25},
-{0x61, 0x7a, 25},
-{0xc2, 0xc2, 46},
-{0xc3, 0xc3, 47},
-{0xc4, 0xca, 48},
-{0xcb, 0xcb, 49},
-{0xcd, 0xcd, 50},
-{0xce, 0xce, 51},
-{0xcf, 0xcf, 52},
-{0xd0, 0xd1, 48},
-{0xd2, 0xd2, 53},
-{0xd3, 0xd3, 48},
-{0xd4, 0xd4, 54},
-{0xd5, 0xd5, 55},
-{0xd6, 0xd6, 56},
-{0xd7, 0xd7, 57},
-{0xd8, 0xd8, 58},
-{0xd9, 0xd9, 122},
-{0xda, 0xda, 48},
-{0xdb, 0xdb, 123},
-{0xdc, 0xdc, 61},
-{0xdd, 0xdd, 62},
-{0xde, 0xde, 63},
-{0xdf, 0xdf, 124},
-{0xe0, 0xe0, 125},
-{0xe1, 0xe1, 126},
-{0xe2, 0xe2, 67},
-{0xe3, 0xe3, 68},
-{0xe4, 0xe4, 69},
-{0xe5, 0xe8, 70},
-{0xe9, 0xe9, 71},
-{0xea, 0xea, 127},
-{0xeb, 0xec, 70},
-{0xed, 0xed, 73},
-{0xef, 0xef, 128},
-{0xf0, 0xf0, 129},
-
-}},
-}}}
-var errMatcherCache = 
-&scan.Matcher{
-EOF: 96,
-Illegal: 95,
-M: &dfa.M{
-States: dfa.States{
-{
-Table: dfa.TransTable{
-{0x00, 0x00, 1},
-{0x22, 0x22, 2},
-{0x27, 0x27, 3},
-{0x2f, 0x2f, 4},
-{0x60, 0x60, 5},
-{0x80, 0xc1, 6},
-{0xc2, 0xdf, 7},
-{0xe0, 0xe0, 8},
-{0xe1, 0xee, 9},
-{0xef, 0xef, 10},
-{0xf0, 0xf0, 11},
-{0xf1, 0xf3, 12},
-{0xf4, 0xf4, 13},
-{0xf5, 0xff, 6},
-
-}},
-{
-Label: 103,
-},
-{
-Label: 112,
-Table: dfa.TransTable{
-{0x00, 0x00, 14},
-{0x01, 0x09, 2},
-{0x0b, 0x21, 2},
-{0x23, 0x5b, 2},
-{0x5c, 0x5c, 15},
-{0x5d, 0x7f, 2},
-{0x80, 0xc1, 16},
-{0xc2, 0xdf, 17},
-{0xe0, 0xe0, 18},
-{0xe1, 0xee, 19},
-{0xef, 0xef, 20},
-{0xf0, 0xf0, 21},
-{0xf1, 0xf3, 22},
-{0xf4, 0xf4, 23},
-{0xf5, 0xff, 16},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x00, 24},
-{0x01, 0x09, 25},
-{0x0b, 0x26, 25},
-{0x27, 0x27, 26},
-{0x28, 0x5b, 25},
-{0x5c, 0x5c, 27},
-{0x5d, 0x7f, 25},
-{0x80, 0xc1, 28},
-{0xc2, 0xdf, 29},
-{0xe0, 0xe0, 30},
-{0xe1, 0xee, 31},
-{0xef, 0xef, 32},
-{0xf0, 0xf0, 33},
-{0xf1, 0xf3, 34},
-{0xf4, 0xf4, 35},
-{0xf5, 0xff, 28},
-
-}},
-{
-Table: dfa.TransTable{{0x2f, 0x2f, 36},
-}},
-{
-Label: 113,
-Table: dfa.TransTable{
-{0x01, 0x5f, 5},
-{0x61, 0x7f, 5},
-{0xc2, 0xdf, 37},
-{0xe0, 0xe0, 38},
-{0xe1, 0xee, 39},
-{0xef, 0xef, 40},
-{0xf0, 0xf0, 41},
-{0xf1, 0xf3, 42},
-{0xf4, 0xf4, 43},
-
-}},
-{
-Label: 116,
-},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 6},
-{0xc0, 0xff, 6},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x9f, 6},
-{0xa0, 0xbf, 7},
-{0xc0, 0xff, 6},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 6},
-{0x80, 0xbf, 7},
-{0xc0, 0xff, 6},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 6},
-{0x80, 0xba, 7},
-{0xbb, 0xbb, 44},
-{0xbc, 0xbf, 7},
-{0xc0, 0xff, 6},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x8f, 6},
-{0x90, 0xbf, 9},
-{0xc0, 0xff, 6},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 6},
-{0x80, 0xbf, 9},
-{0xc0, 0xff, 6},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 6},
-{0x80, 0x8f, 9},
-{0x90, 0xff, 6},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 14},
-{0x0b, 0x21, 14},
-{0x22, 0x22, 45},
-{0x23, 0xff, 14},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x21, 46},
-{0x22, 0x22, 2},
-{0x23, 0x26, 46},
-{0x27, 0x27, 2},
-{0x28, 0x2f, 46},
-{0x30, 0x37, 47},
-{0x38, 0x54, 46},
-{0x55, 0x55, 48},
-{0x56, 0x5b, 46},
-{0x5c, 0x5c, 2},
-{0x5d, 0x60, 46},
-{0x61, 0x62, 2},
-{0x63, 0x65, 46},
-{0x66, 0x66, 2},
-{0x67, 0x6d, 46},
-{0x6e, 0x6e, 2},
-{0x6f, 0x71, 46},
-{0x72, 0x72, 2},
-{0x73, 0x73, 46},
-{0x74, 0x74, 2},
-{0x75, 0x75, 49},
-{0x76, 0x76, 2},
-{0x77, 0x77, 46},
-{0x78, 0x78, 50},
-{0x79, 0xff, 46},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 16},
-{0x0b, 0x21, 16},
-{0x22, 0x22, 51},
-{0x23, 0xff, 16},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 16},
-{0x80, 0xbf, 2},
-{0xc0, 0xff, 16},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x9f, 16},
-{0xa0, 0xbf, 17},
-{0xc0, 0xff, 16},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 16},
-{0x80, 0xbf, 17},
-{0xc0, 0xff, 16},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 16},
-{0x80, 0xba, 17},
-{0xbb, 0xbb, 52},
-{0xbc, 0xbf, 17},
-{0xc0, 0xff, 16},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x8f, 16},
-{0x90, 0xbf, 19},
-{0xc0, 0xff, 16},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 16},
-{0x80, 0xbf, 19},
-{0xc0, 0xff, 16},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 16},
-{0x80, 0x8f, 19},
-{0x90, 0xff, 16},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 24},
-{0x0b, 0x26, 24},
-{0x28, 0x5b, 24},
-{0x5d, 0xff, 24},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 53},
-{0x0b, 0x26, 53},
-{0x28, 0x5b, 53},
-{0x5c, 0x5c, 54},
-{0x5d, 0xff, 53},
-
-}},
-{
-Label: 115,
-},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x21, 55},
-{0x22, 0x22, 56},
-{0x23, 0x26, 55},
-{0x27, 0x27, 57},
-{0x28, 0x2f, 55},
-{0x30, 0x37, 58},
-{0x38, 0x54, 55},
-{0x55, 0x55, 59},
-{0x56, 0x5b, 55},
-{0x5c, 0x5c, 56},
-{0x5d, 0x60, 55},
-{0x61, 0x62, 56},
-{0x63, 0x65, 55},
-{0x66, 0x66, 56},
-{0x67, 0x6d, 55},
-{0x6e, 0x6e, 56},
-{0x6f, 0x71, 55},
-{0x72, 0x72, 56},
-{0x73, 0x73, 55},
-{0x74, 0x74, 56},
-{0x75, 0x75, 60},
-{0x76, 0x76, 56},
-{0x77, 0x77, 55},
-{0x78, 0x78, 61},
-{0x79, 0xff, 55},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 24},
-{0x0b, 0x26, 24},
-{0x27, 0x27, 62},
-{0x28, 0x5b, 24},
-{0x5d, 0xff, 24},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x7f, 28},
-{0x80, 0xbf, 25},
-{0xc0, 0xff, 28},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x9f, 28},
-{0xa0, 0xbf, 29},
-{0xc0, 0xff, 28},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x7f, 28},
-{0x80, 0xbf, 29},
-{0xc0, 0xff, 28},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x7f, 28},
-{0x80, 0xba, 29},
-{0xbb, 0xbb, 64},
-{0xbc, 0xbf, 29},
-{0xc0, 0xff, 28},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x8f, 28},
-{0x90, 0xbf, 31},
-{0xc0, 0xff, 28},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x7f, 28},
-{0x80, 0xbf, 31},
-{0xc0, 0xff, 28},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x7f, 28},
-{0x80, 0x8f, 31},
-{0x90, 0xff, 28},
-
-}},
-{
-Table: dfa.TransTable{
-{0x01, 0x09, 36},
-{0x0b, 0x7f, 36},
-{0xc2, 0xdf, 65},
-{0xe0, 0xe0, 66},
-{0xe1, 0xee, 67},
-{0xef, 0xef, 68},
-{0xf0, 0xf0, 69},
-{0xf1, 0xf3, 70},
-{0xf4, 0xf4, 71},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 5},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 37},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 37},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 37},
-{0xbb, 0xbb, 72},
-{0xbc, 0xbf, 37},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 39},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 39},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 39},
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 6},
-{0xbf, 0xbf, 73},
-{0xc0, 0xff, 6},
-
-}},
-{
-Label: 104,
-},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 46},
-{0x0b, 0x21, 46},
-{0x22, 0x22, 74},
-{0x23, 0xff, 46},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x37, 77},
-{0x38, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x30, 78},
-{0x31, 0x39, 79},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 79},
-{0x47, 0x60, 75},
-{0x61, 0x66, 79},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 80},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 80},
-{0x47, 0x60, 75},
-{0x61, 0x66, 80},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 81},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 81},
-{0x47, 0x60, 75},
-{0x61, 0x66, 81},
-{0x67, 0xff, 75},
-
-}},
-{
-Label: 118,
-},
-{
-Table: dfa.TransTable{
-{0x00, 0x7f, 16},
-{0x80, 0xbe, 2},
-{0xbf, 0xbf, 82},
-{0xc0, 0xff, 16},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 53},
-{0x0b, 0x26, 53},
-{0x27, 0x27, 26},
-{0x28, 0x5b, 53},
-{0x5c, 0x5c, 54},
-{0x5d, 0xff, 53},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 54},
-{0x0b, 0x26, 54},
-{0x27, 0x27, 26},
-{0x28, 0xff, 54},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 55},
-{0x27, 0x27, 83},
-{0x28, 0xff, 55},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 84},
-{0x0a, 0x0a, 85},
-{0x0b, 0x26, 84},
-{0x28, 0xff, 84},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 54},
-{0x0b, 0x26, 54},
-{0x28, 0xff, 54},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x37, 88},
-{0x38, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x30, 89},
-{0x31, 0x39, 90},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 90},
-{0x47, 0x60, 86},
-{0x61, 0x66, 90},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x39, 91},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 91},
-{0x47, 0x60, 86},
-{0x61, 0x66, 91},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x39, 92},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 92},
-{0x47, 0x60, 86},
-{0x61, 0x66, 92},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 117,
-},
-{
-Table: dfa.TransTable{{0x27, 0x27, 62},
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 28},
-{0x0a, 0x0a, 63},
-{0x0b, 0x26, 28},
-{0x27, 0x27, 63},
-{0x28, 0x5b, 28},
-{0x5c, 0x5c, 63},
-{0x5d, 0x7f, 28},
-{0x80, 0xbe, 25},
-{0xbf, 0xbf, 93},
-{0xc0, 0xff, 28},
-
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 36},
-}},
-{
-Table: dfa.TransTable{{0xa0, 0xbf, 65},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 65},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xba, 65},
-{0xbb, 0xbb, 94},
-{0xbc, 0xbf, 65},
-
-}},
-{
-Table: dfa.TransTable{{0x90, 0xbf, 67},
-}},
-{
-Table: dfa.TransTable{{0x80, 0xbf, 67},
-}},
-{
-Table: dfa.TransTable{{0x80, 0x8f, 67},
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xbe, 5},
-{0xbf, 0xbf, 95},
-
-}},
-{
-Label: 99,
-},
-{
-Label: 107,
-},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0xff, 75},
-
-}},
-{
-Label: 105,
-},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x37, 2},
-{0x38, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x30, 96},
-{0x31, 0x39, 97},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 97},
-{0x47, 0x60, 75},
-{0x61, 0x66, 97},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 97},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 97},
-{0x47, 0x60, 75},
-{0x61, 0x66, 97},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 50},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 50},
-{0x47, 0x60, 75},
-{0x61, 0x66, 50},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 2},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 2},
-{0x47, 0x60, 75},
-{0x61, 0x66, 2},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 82},
-{0x0b, 0x21, 82},
-{0x22, 0x22, 98},
-{0x23, 0xff, 82},
-
-}},
-{
-Label: 107,
-},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 84},
-{0x0a, 0x0a, 85},
-{0x0b, 0x26, 84},
-{0x27, 0x27, 26},
-{0x28, 0xff, 84},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x28, 0xff, 85},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x27, 0x27, 87},
-{0x28, 0xff, 85},
-
-}},
-{
-Label: 105,
-},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x37, 56},
-{0x38, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x30, 99},
-{0x31, 0x39, 100},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 100},
-{0x47, 0x60, 86},
-{0x61, 0x66, 100},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 101},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 101},
-{0x47, 0x60, 85},
-{0x61, 0x66, 101},
-{0x67, 0xff, 85},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x39, 61},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 61},
-{0x47, 0x60, 86},
-{0x61, 0x66, 61},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x39, 56},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 56},
-{0x47, 0x60, 86},
-{0x61, 0x66, 56},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 110,
-Table: dfa.TransTable{
-{0x00, 0x09, 24},
-{0x0b, 0x26, 24},
-{0x27, 0x27, 102},
-{0x28, 0x5b, 24},
-{0x5d, 0xff, 24},
-
-}},
-{
-Table: dfa.TransTable{
-{0x80, 0xbe, 36},
-{0xbf, 0xbf, 103},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x5f, 95},
-{0x60, 0x60, 104},
-{0x61, 0xff, 95},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x30, 105},
-{0x31, 0x31, 106},
-{0x32, 0x39, 107},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 107},
-{0x47, 0x60, 75},
-{0x61, 0x66, 107},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 107},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 107},
-{0x47, 0x60, 75},
-{0x61, 0x66, 107},
-{0x67, 0xff, 75},
-
-}},
-{
-Label: 102,
-},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x30, 108},
-{0x31, 0x31, 109},
-{0x32, 0x39, 110},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 110},
-{0x47, 0x60, 86},
-{0x61, 0x66, 110},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 111},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 111},
-{0x47, 0x60, 85},
-{0x61, 0x66, 111},
-{0x67, 0xff, 85},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 111},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 111},
-{0x47, 0x60, 85},
-{0x61, 0x66, 111},
-{0x67, 0xff, 85},
-
-}},
-{
-Label: 101,
-},
-{
-Label: 100,
-Table: dfa.TransTable{
-{0x00, 0x09, 103},
-{0x0a, 0x0a, 112},
-{0x0b, 0xff, 103},
-
-}},
-{
-Label: 102,
-},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 49},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 49},
-{0x47, 0x60, 75},
-{0x61, 0x66, 49},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x30, 49},
-{0x31, 0x39, 113},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 113},
-{0x47, 0x60, 75},
-{0x61, 0x66, 113},
-{0x67, 0xff, 75},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 113},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 113},
-{0x47, 0x60, 75},
-{0x61, 0x66, 113},
-{0x67, 0xff, 75},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x39, 60},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 60},
-{0x47, 0x60, 86},
-{0x61, 0x66, 60},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x09, 86},
-{0x0a, 0x0a, 85},
-{0x0b, 0x21, 86},
-{0x22, 0x22, 85},
-{0x23, 0x26, 86},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 86},
-{0x30, 0x30, 60},
-{0x31, 0x39, 114},
-{0x3a, 0x40, 86},
-{0x41, 0x46, 114},
-{0x47, 0x60, 86},
-{0x61, 0x66, 114},
-{0x67, 0xff, 86},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 115},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 115},
-{0x47, 0x60, 85},
-{0x61, 0x66, 115},
-{0x67, 0xff, 85},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 115},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 115},
-{0x47, 0x60, 85},
-{0x61, 0x66, 115},
-{0x67, 0xff, 85},
-
-}},
-{
-Label: 100,
-},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 116},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 116},
-{0x47, 0x60, 75},
-{0x61, 0x66, 116},
-{0x67, 0xff, 75},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x27, 0x27, 87},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 117},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 117},
-{0x47, 0x60, 85},
-{0x61, 0x66, 117},
-{0x67, 0xff, 85},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 117},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 117},
-{0x47, 0x60, 85},
-{0x61, 0x66, 117},
-{0x67, 0xff, 85},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 118},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 118},
-{0x47, 0x60, 75},
-{0x61, 0x66, 118},
-{0x67, 0xff, 75},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 119},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 119},
-{0x47, 0x60, 85},
-{0x61, 0x66, 119},
-{0x67, 0xff, 85},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 120},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 120},
-{0x47, 0x60, 75},
-{0x61, 0x66, 120},
-{0x67, 0xff, 75},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 121},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 121},
-{0x47, 0x60, 85},
-{0x61, 0x66, 121},
-{0x67, 0xff, 85},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 75},
-{0x0b, 0x21, 75},
-{0x22, 0x22, 76},
-{0x23, 0x2f, 75},
-{0x30, 0x39, 122},
-{0x3a, 0x40, 75},
-{0x41, 0x46, 122},
-{0x47, 0x60, 75},
-{0x61, 0x66, 122},
-{0x67, 0xff, 75},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x28, 0x2f, 85},
-{0x30, 0x39, 123},
-{0x3a, 0x40, 85},
-{0x41, 0x46, 123},
-{0x47, 0x60, 85},
-{0x61, 0x66, 123},
-{0x67, 0xff, 85},
-
-}},
-{
-Table: dfa.TransTable{
-{0x00, 0x09, 122},
-{0x0b, 0x21, 122},
-{0x22, 0x22, 124},
-{0x23, 0xff, 122},
-
-}},
-{
-Label: 111,
-Table: dfa.TransTable{
-{0x00, 0x26, 85},
-{0x27, 0x27, 125},
-{0x28, 0xff, 85},
 
-}},
-{
-Label: 106,
-},
-{
-Label: 106,
-},
-}}}
+var tokMatcherCache = &scan.Matcher{
+	EOF:     1,
+	Illegal: 0,
+	M: &dfa.M{
+		States: dfa.States{
+			{
+				Table: dfa.TransTable{
+					{0x09, 0x09, 1},
+					{0x0a, 0x0a, 2},
+					{0x0d, 0x0d, 3},
+					{0x20, 0x20, 4},
+					{0x21, 0x21, 5},
+					{0x22, 0x22, 6},
+					{0x25, 0x25, 7},
+					{0x26, 0x26, 8},
+					{0x27, 0x27, 9},
+					{0x28, 0x28, 10},
+					{0x29, 0x29, 11},
+					{0x2a, 0x2a, 12},
+					{0x2b, 0x2b, 13},
+					{0x2c, 0x2c, 14},
+					{0x2d, 0x2d, 15},
+					{0x2e, 0x2e, 16},
+					{0x2f, 0x2f, 17},
+					{0x30, 0x30, 18},
+					{0x31, 0x39, 19},
+					{0x3a, 0x3a, 20},
+					{0x3b, 0x3b, 21},
+					{0x3c, 0x3c, 22},
+					{0x3d, 0x3d, 23},
+					{0x3e, 0x3e, 24},
+					{0x41, 0x5a, 25},
+					{0x5b, 0x5b, 26},
+					{0x5d, 0x5d, 27},
+					{0x5e, 0x5e, 28},
+					{0x5f, 0x5f, 25},
+					{0x60, 0x60, 29},
+					{0x61, 0x61, 25},
+					{0x62, 0x62, 30},
+					{0x63, 0x63, 31},
+					{0x64, 0x64, 32},
+					{0x65, 0x65, 33},
+					{0x66, 0x66, 34},
+					{0x67, 0x67, 35},
+					{0x68, 0x68, 25},
+					{0x69, 0x69, 36},
+					{0x6a, 0x6c, 25},
+					{0x6d, 0x6d, 37},
+					{0x6e, 0x6f, 25},
+					{0x70, 0x70, 38},
+					{0x71, 0x71, 25},
+					{0x72, 0x72, 39},
+					{0x73, 0x73, 40},
+					{0x74, 0x74, 41},
+					{0x75, 0x75, 25},
+					{0x76, 0x76, 42},
+					{0x77, 0x7a, 25},
+					{0x7b, 0x7b, 43},
+					{0x7c, 0x7c, 44},
+					{0x7d, 0x7d, 45},
+					{0x7e, 0x7e, 46},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 60},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 61},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 65},
+					{0xe0, 0xe0, 66},
+					{0xe1, 0xe1, 67},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 73},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 75},
+					{0xf0, 0xf0, 76},
+				}},
+			{
+				Label: 92,
+				Table: dfa.TransTable{
+					{0x09, 0x09, 1},
+					{0x0d, 0x0d, 3},
+					{0x20, 0x20, 4},
+				}},
+			{
+				Label: 91,
+			},
+			{
+				Label: 92,
+				Table: dfa.TransTable{
+					{0x09, 0x09, 1},
+					{0x0d, 0x0d, 3},
+					{0x20, 0x20, 4},
+				}},
+			{
+				Label: 92,
+				Table: dfa.TransTable{
+					{0x09, 0x09, 1},
+					{0x0d, 0x0d, 3},
+					{0x20, 0x20, 4},
+				}},
+			{
+				Label: 45,
+				Table: dfa.TransTable{{0x3d, 0x3d, 77}}},
+			{
+				Table: dfa.TransTable{
+					{0x01, 0x09, 6},
+					{0x0b, 0x21, 6},
+					{0x22, 0x22, 78},
+					{0x23, 0x5b, 6},
+					{0x5c, 0x5c, 79},
+					{0x5d, 0x7f, 6},
+					{0xc2, 0xdf, 80},
+					{0xe0, 0xe0, 81},
+					{0xe1, 0xee, 82},
+					{0xef, 0xef, 83},
+					{0xf0, 0xf0, 84},
+					{0xf1, 0xf3, 85},
+					{0xf4, 0xf4, 86},
+				}},
+			{
+				Label: 18,
+				Table: dfa.TransTable{{0x3d, 0x3d, 87}}},
+			{
+				Label: 19,
+				Table: dfa.TransTable{
+					{0x26, 0x26, 88},
+					{0x3d, 0x3d, 89},
+					{0x5e, 0x5e, 90},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x01, 0x09, 91},
+					{0x0b, 0x26, 91},
+					{0x28, 0x5b, 91},
+					{0x5c, 0x5c, 92},
+					{0x5d, 0x7f, 91},
+					{0xc2, 0xdf, 93},
+					{0xe0, 0xe0, 94},
+					{0xe1, 0xee, 95},
+					{0xef, 0xef, 96},
+					{0xf0, 0xf0, 97},
+					{0xf1, 0xf3, 98},
+					{0xf4, 0xf4, 99},
+				}},
+			{
+				Label: 51,
+			},
+			{
+				Label: 56,
+			},
+			{
+				Label: 16,
+				Table: dfa.TransTable{{0x3d, 0x3d, 100}}},
+			{
+				Label: 14,
+				Table: dfa.TransTable{
+					{0x2b, 0x2b, 101},
+					{0x3d, 0x3d, 102},
+				}},
+			{
+				Label: 54,
+			},
+			{
+				Label: 15,
+				Table: dfa.TransTable{
+					{0x2d, 0x2d, 103},
+					{0x3d, 0x3d, 104},
+				}},
+			{
+				Label: 55,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 105},
+					{0x30, 0x39, 106},
+				}},
+			{
+				Label: 17,
+				Table: dfa.TransTable{
+					{0x2a, 0x2a, 107},
+					{0x2f, 0x2f, 108},
+					{0x3d, 0x3d, 109},
+				}},
+			{
+				Label: 7,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 110},
+					{0x30, 0x37, 111},
+					{0x38, 0x39, 112},
+					{0x42, 0x42, 113},
+					{0x45, 0x45, 114},
+					{0x4f, 0x4f, 115},
+					{0x50, 0x50, 116},
+					{0x58, 0x58, 117},
+					{0x5f, 0x5f, 118},
+					{0x62, 0x62, 113},
+					{0x65, 0x65, 114},
+					{0x69, 0x69, 119},
+					{0x6f, 0x6f, 115},
+					{0x70, 0x70, 116},
+					{0x78, 0x78, 117},
+				}},
+			{
+				Label: 7,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 110},
+					{0x30, 0x39, 19},
+					{0x45, 0x45, 114},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 120},
+					{0x65, 0x65, 114},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 60,
+				Table: dfa.TransTable{{0x3d, 0x3d, 121}}},
+			{
+				Label: 59,
+			},
+			{
+				Label: 42,
+				Table: dfa.TransTable{
+					{0x2d, 0x2d, 122},
+					{0x3c, 0x3c, 123},
+					{0x3d, 0x3d, 124},
+				}},
+			{
+				Label: 44,
+				Table: dfa.TransTable{{0x3d, 0x3d, 125}}},
+			{
+				Label: 43,
+				Table: dfa.TransTable{
+					{0x3d, 0x3d, 126},
+					{0x3e, 0x3e, 127},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 52,
+			},
+			{
+				Label: 57,
+			},
+			{
+				Label: 21,
+				Table: dfa.TransTable{{0x3d, 0x3d, 136}}},
+			{
+				Table: dfa.TransTable{
+					{0x01, 0x5f, 29},
+					{0x60, 0x60, 137},
+					{0x61, 0x7f, 29},
+					{0xc2, 0xdf, 138},
+					{0xe0, 0xe0, 139},
+					{0xe1, 0xee, 140},
+					{0xef, 0xef, 141},
+					{0xf0, 0xf0, 142},
+					{0xf1, 0xf3, 143},
+					{0xf4, 0xf4, 144},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 145},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 146},
+					{0x62, 0x67, 25},
+					{0x68, 0x68, 147},
+					{0x69, 0x6e, 25},
+					{0x6f, 0x6f, 148},
+					{0x70, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 149},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6b, 25},
+					{0x6c, 0x6c, 150},
+					{0x6d, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 151},
+					{0x62, 0x6e, 25},
+					{0x6f, 0x6f, 152},
+					{0x70, 0x74, 25},
+					{0x75, 0x75, 153},
+					{0x76, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6e, 25},
+					{0x6f, 0x6f, 154},
+					{0x70, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x65, 25},
+					{0x66, 0x66, 155},
+					{0x67, 0x6c, 25},
+					{0x6d, 0x6d, 156},
+					{0x6e, 0x6e, 157},
+					{0x6f, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 158},
+					{0x62, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 159},
+					{0x62, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 160},
+					{0x62, 0x64, 25},
+					{0x65, 0x65, 161},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 162},
+					{0x66, 0x73, 25},
+					{0x74, 0x74, 163},
+					{0x75, 0x76, 25},
+					{0x77, 0x77, 164},
+					{0x78, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x78, 25},
+					{0x79, 0x79, 165},
+					{0x7a, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 166},
+					{0x62, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 53,
+			},
+			{
+				Label: 20,
+				Table: dfa.TransTable{
+					{0x3d, 0x3d, 167},
+					{0x7c, 0x7c, 168},
+				}},
+			{
+				Label: 58,
+			},
+			{
+				Label: 90,
+			},
+			{
+				Table: dfa.TransTable{
+					{0xaa, 0xaa, 25},
+					{0xb5, 0xb5, 25},
+					{0xba, 0xba, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x96, 25},
+					{0x98, 0xb6, 25},
+					{0xb8, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x81, 25},
+					{0x86, 0x91, 25},
+					{0xa0, 0xa4, 25},
+					{0xac, 0xac, 25},
+					{0xae, 0xae, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xb0, 0xb4, 25},
+					{0xb6, 0xb7, 25},
+					{0xba, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x86, 0x86, 25},
+					{0x88, 0x8a, 25},
+					{0x8c, 0x8c, 25},
+					{0x8e, 0xa1, 25},
+					{0xa3, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xb5, 25},
+					{0xb7, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x81, 25},
+					{0x8a, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa7, 25},
+					{0xb1, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x96, 25},
+					{0x99, 0x99, 25},
+					{0xa1, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x87, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0xaa, 25},
+					{0xb0, 0xb2, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8a, 25},
+					{0xae, 0xaf, 25},
+					{0xb1, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x93, 25},
+					{0x95, 0x95, 25},
+					{0xa5, 0xa6, 25},
+					{0xae, 0xaf, 25},
+					{0xba, 0xbc, 25},
+					{0xbf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 25},
+					{0x92, 0xaf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x8d, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa5, 25},
+					{0xb1, 0xb1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x8a, 0xaa, 25},
+					{0xb4, 0xb5, 25},
+					{0xba, 0xba, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xa0, 0xa0, 169},
+					{0xa1, 0xa1, 170},
+					{0xa2, 0xa2, 171},
+					{0xa4, 0xa4, 172},
+					{0xa5, 0xa5, 173},
+					{0xa6, 0xa6, 174},
+					{0xa7, 0xa7, 175},
+					{0xa8, 0xa8, 176},
+					{0xa9, 0xa9, 177},
+					{0xaa, 0xaa, 178},
+					{0xab, 0xab, 179},
+					{0xac, 0xac, 180},
+					{0xad, 0xad, 181},
+					{0xae, 0xae, 182},
+					{0xaf, 0xaf, 183},
+					{0xb0, 0xb0, 184},
+					{0xb1, 0xb1, 185},
+					{0xb2, 0xb2, 184},
+					{0xb3, 0xb3, 186},
+					{0xb4, 0xb4, 187},
+					{0xb5, 0xb5, 188},
+					{0xb6, 0xb6, 189},
+					{0xb7, 0xb7, 190},
+					{0xb8, 0xb8, 191},
+					{0xb9, 0xb9, 190},
+					{0xba, 0xba, 192},
+					{0xbb, 0xbb, 193},
+					{0xbc, 0xbc, 194},
+					{0xbd, 0xbd, 195},
+					{0xbe, 0xbe, 196},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 197},
+					{0x81, 0x81, 198},
+					{0x82, 0x82, 199},
+					{0x83, 0x83, 200},
+					{0x84, 0x88, 49},
+					{0x89, 0x89, 201},
+					{0x8a, 0x8a, 202},
+					{0x8b, 0x8b, 203},
+					{0x8c, 0x8c, 204},
+					{0x8d, 0x8d, 205},
+					{0x8e, 0x8e, 206},
+					{0x8f, 0x8f, 207},
+					{0x90, 0x90, 208},
+					{0x91, 0x98, 49},
+					{0x99, 0x99, 209},
+					{0x9a, 0x9a, 210},
+					{0x9b, 0x9b, 211},
+					{0x9c, 0x9c, 212},
+					{0x9d, 0x9d, 213},
+					{0x9e, 0x9e, 214},
+					{0x9f, 0x9f, 215},
+					{0xa0, 0xa0, 59},
+					{0xa1, 0xa1, 216},
+					{0xa2, 0xa2, 217},
+					{0xa3, 0xa3, 218},
+					{0xa4, 0xa4, 219},
+					{0xa5, 0xa5, 220},
+					{0xa6, 0xa6, 221},
+					{0xa7, 0xa7, 222},
+					{0xa8, 0xa8, 223},
+					{0xa9, 0xa9, 224},
+					{0xaa, 0xaa, 225},
+					{0xac, 0xac, 226},
+					{0xad, 0xad, 227},
+					{0xae, 0xae, 228},
+					{0xaf, 0xaf, 229},
+					{0xb0, 0xb0, 230},
+					{0xb1, 0xb1, 231},
+					{0xb3, 0xb3, 232},
+					{0xb4, 0xb6, 49},
+					{0xb8, 0xbb, 49},
+					{0xbc, 0xbc, 233},
+					{0xbd, 0xbd, 234},
+					{0xbe, 0xbe, 235},
+					{0xbf, 0xbf, 236},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0x81, 237},
+					{0x82, 0x82, 238},
+					{0x84, 0x84, 239},
+					{0x85, 0x85, 240},
+					{0x86, 0x86, 241},
+					{0xb0, 0xb0, 242},
+					{0xb1, 0xb1, 243},
+					{0xb2, 0xb2, 49},
+					{0xb3, 0xb3, 244},
+					{0xb4, 0xb4, 245},
+					{0xb5, 0xb5, 246},
+					{0xb6, 0xb6, 247},
+					{0xb7, 0xb7, 248},
+					{0xb8, 0xb8, 249},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 250},
+					{0x81, 0x81, 208},
+					{0x82, 0x82, 251},
+					{0x83, 0x83, 252},
+					{0x84, 0x84, 253},
+					{0x85, 0x85, 49},
+					{0x86, 0x86, 254},
+					{0x87, 0x87, 255},
+					{0x90, 0xbf, 49},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xb5, 49},
+					{0xb6, 0xb6, 218},
+					{0xb8, 0xbf, 49},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 49}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xbe, 49},
+					{0xbf, 0xbf, 256},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x91, 49},
+					{0x92, 0x92, 256},
+					{0x93, 0x93, 257},
+					{0x94, 0x97, 49},
+					{0x98, 0x98, 258},
+					{0x99, 0x99, 259},
+					{0x9a, 0x9a, 260},
+					{0x9b, 0x9b, 229},
+					{0x9c, 0x9c, 261},
+					{0x9d, 0x9d, 49},
+					{0x9e, 0x9e, 262},
+					{0x9f, 0x9f, 263},
+					{0xa0, 0xa0, 264},
+					{0xa1, 0xa1, 214},
+					{0xa2, 0xa2, 265},
+					{0xa3, 0xa3, 266},
+					{0xa4, 0xa4, 267},
+					{0xa5, 0xa5, 268},
+					{0xa6, 0xa6, 269},
+					{0xa7, 0xa7, 270},
+					{0xa8, 0xa8, 271},
+					{0xa9, 0xa9, 272},
+					{0xaa, 0xaa, 273},
+					{0xab, 0xab, 274},
+					{0xac, 0xac, 275},
+					{0xaf, 0xaf, 276},
+					{0xb0, 0xbf, 49},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9d, 49},
+					{0x9e, 0x9e, 277},
+					{0x9f, 0x9f, 278},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xa4, 0xa8, 49},
+					{0xa9, 0xa9, 279},
+					{0xaa, 0xaa, 49},
+					{0xab, 0xab, 280},
+					{0xac, 0xac, 281},
+					{0xad, 0xad, 282},
+					{0xae, 0xae, 283},
+					{0xaf, 0xaf, 284},
+					{0xb0, 0xb3, 49},
+					{0xb4, 0xb4, 285},
+					{0xb5, 0xb5, 286},
+					{0xb6, 0xb6, 287},
+					{0xb7, 0xb7, 288},
+					{0xb9, 0xb9, 289},
+					{0xba, 0xba, 49},
+					{0xbb, 0xbb, 290},
+					{0xbc, 0xbc, 291},
+					{0xbd, 0xbd, 292},
+					{0xbe, 0xbe, 293},
+					{0xbf, 0xbf, 294},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 295},
+					{0x91, 0x91, 296},
+					{0x92, 0x92, 297},
+					{0x93, 0x93, 298},
+					{0x96, 0x96, 299},
+					{0x9b, 0x9b, 300},
+					{0x9d, 0x9d, 301},
+					{0x9e, 0x9e, 302},
+					{0xa0, 0xa9, 71},
+					{0xaa, 0xaa, 303},
+					{0xab, 0xab, 304},
+					{0xaf, 0xaf, 305},
+				}},
+			{
+				Label: 46,
+			},
+			{
+				Label: 99,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x22, 0x22, 6},
+					{0x27, 0x27, 6},
+					{0x30, 0x37, 306},
+					{0x55, 0x55, 307},
+					{0x5c, 0x5c, 6},
+					{0x61, 0x62, 6},
+					{0x66, 0x66, 6},
+					{0x6e, 0x6e, 6},
+					{0x72, 0x72, 6},
+					{0x74, 0x74, 6},
+					{0x75, 0x75, 308},
+					{0x76, 0x76, 6},
+					{0x78, 0x78, 309},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 6}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 80}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 80}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 80},
+					{0xbb, 0xbb, 310},
+					{0xbc, 0xbf, 80},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 82}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 82}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 82}}},
+			{
+				Label: 29,
+			},
+			{
+				Label: 36,
+			},
+			{
+				Label: 30,
+			},
+			{
+				Label: 24,
+				Table: dfa.TransTable{{0x3d, 0x3d, 311}}},
+			{
+				Table: dfa.TransTable{{0x27, 0x27, 312}}},
+			{
+				Table: dfa.TransTable{
+					{0x22, 0x22, 91},
+					{0x27, 0x27, 91},
+					{0x30, 0x37, 313},
+					{0x55, 0x55, 314},
+					{0x5c, 0x5c, 91},
+					{0x61, 0x62, 91},
+					{0x66, 0x66, 91},
+					{0x6e, 0x6e, 91},
+					{0x72, 0x72, 91},
+					{0x74, 0x74, 91},
+					{0x75, 0x75, 315},
+					{0x76, 0x76, 91},
+					{0x78, 0x78, 316},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 91}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 93}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 93}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 93},
+					{0xbb, 0xbb, 317},
+					{0xbc, 0xbf, 93},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 95}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 95}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 95}}},
+			{
+				Label: 27,
+			},
+			{
+				Label: 39,
+			},
+			{
+				Label: 25,
+			},
+			{
+				Label: 40,
+			},
+			{
+				Label: 26,
+			},
+			{
+				Table: dfa.TransTable{{0x2e, 0x2e, 318}}},
+			{
+				Label: 8,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 106},
+					{0x45, 0x45, 319},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 320},
+					{0x65, 0x65, 319},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 112,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 107},
+					{0x0a, 0x0a, 321},
+					{0x0b, 0x29, 107},
+					{0x2a, 0x2a, 322},
+					{0x2b, 0x7f, 107},
+					{0xc2, 0xdf, 323},
+					{0xe0, 0xe0, 324},
+					{0xe1, 0xee, 325},
+					{0xef, 0xef, 326},
+					{0xf0, 0xf0, 327},
+					{0xf1, 0xf3, 328},
+					{0xf4, 0xf4, 329},
+				}},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 330},
+					{0x0a, 0x0a, 331},
+					{0x0b, 0x6b, 330},
+					{0x6c, 0x6c, 332},
+					{0x6d, 0x7f, 330},
+					{0xc2, 0xdf, 333},
+					{0xe0, 0xe0, 334},
+					{0xe1, 0xee, 335},
+					{0xef, 0xef, 336},
+					{0xf0, 0xf0, 337},
+					{0xf1, 0xf3, 338},
+					{0xf4, 0xf4, 339},
+				}},
+			{
+				Label: 28,
+			},
+			{
+				Label: 8,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 340},
+					{0x45, 0x45, 341},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 342},
+					{0x65, 0x65, 341},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 7,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 110},
+					{0x30, 0x37, 111},
+					{0x38, 0x39, 112},
+					{0x45, 0x45, 114},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 118},
+					{0x65, 0x65, 114},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 110},
+					{0x30, 0x39, 112},
+					{0x45, 0x45, 114},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 343},
+					{0x65, 0x65, 114},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x31, 345},
+					{0x32, 0x39, 346},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 347},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 348},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2b, 0x2b, 349},
+					{0x2d, 0x2d, 349},
+					{0x30, 0x39, 350},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x37, 352},
+					{0x38, 0x39, 353},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 354},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 348},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2b, 0x2b, 351},
+					{0x2d, 0x2d, 351},
+					{0x30, 0x39, 351},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 355},
+					{0x30, 0x39, 356},
+					{0x41, 0x46, 356},
+					{0x50, 0x50, 357},
+					{0x5f, 0x5f, 358},
+					{0x61, 0x66, 356},
+					{0x69, 0x69, 359},
+					{0x70, 0x70, 357},
+				}},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 342},
+					{0x30, 0x37, 111},
+					{0x38, 0x39, 112},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 360},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 9,
+			},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 342},
+					{0x30, 0x39, 19},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 362},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 49,
+			},
+			{
+				Label: 38,
+			},
+			{
+				Label: 22,
+				Table: dfa.TransTable{{0x3d, 0x3d, 363}}},
+			{
+				Label: 47,
+			},
+			{
+				Label: 41,
+			},
+			{
+				Label: 48,
+			},
+			{
+				Label: 23,
+				Table: dfa.TransTable{{0x3d, 0x3d, 364}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8a, 25},
+					{0xa0, 0xa9, 25},
+					{0xae, 0xaf, 25},
+					{0xb1, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x93, 25},
+					{0x95, 0x95, 25},
+					{0xa5, 0xa6, 25},
+					{0xae, 0xbc, 25},
+					{0xbf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xaa, 25},
+					{0xb4, 0xb5, 25},
+					{0xba, 0xba, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xa0, 0xa0, 169},
+					{0xa1, 0xa1, 170},
+					{0xa2, 0xa2, 171},
+					{0xa4, 0xa4, 172},
+					{0xa5, 0xa5, 365},
+					{0xa6, 0xa6, 174},
+					{0xa7, 0xa7, 366},
+					{0xa8, 0xa8, 176},
+					{0xa9, 0xa9, 367},
+					{0xaa, 0xaa, 178},
+					{0xab, 0xab, 368},
+					{0xac, 0xac, 180},
+					{0xad, 0xad, 369},
+					{0xae, 0xae, 182},
+					{0xaf, 0xaf, 370},
+					{0xb0, 0xb0, 184},
+					{0xb1, 0xb1, 371},
+					{0xb2, 0xb2, 184},
+					{0xb3, 0xb3, 372},
+					{0xb4, 0xb4, 187},
+					{0xb5, 0xb5, 373},
+					{0xb6, 0xb6, 189},
+					{0xb7, 0xb7, 374},
+					{0xb8, 0xb8, 191},
+					{0xb9, 0xb9, 375},
+					{0xba, 0xba, 192},
+					{0xbb, 0xbb, 376},
+					{0xbc, 0xbc, 377},
+					{0xbd, 0xbd, 195},
+					{0xbe, 0xbe, 196},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 197},
+					{0x81, 0x81, 378},
+					{0x82, 0x82, 379},
+					{0x83, 0x83, 200},
+					{0x84, 0x88, 49},
+					{0x89, 0x89, 201},
+					{0x8a, 0x8a, 202},
+					{0x8b, 0x8b, 203},
+					{0x8c, 0x8c, 204},
+					{0x8d, 0x8d, 205},
+					{0x8e, 0x8e, 206},
+					{0x8f, 0x8f, 207},
+					{0x90, 0x90, 208},
+					{0x91, 0x98, 49},
+					{0x99, 0x99, 209},
+					{0x9a, 0x9a, 210},
+					{0x9b, 0x9b, 211},
+					{0x9c, 0x9c, 212},
+					{0x9d, 0x9d, 213},
+					{0x9e, 0x9e, 214},
+					{0x9f, 0x9f, 380},
+					{0xa0, 0xa0, 381},
+					{0xa1, 0xa1, 216},
+					{0xa2, 0xa2, 217},
+					{0xa3, 0xa3, 218},
+					{0xa4, 0xa4, 219},
+					{0xa5, 0xa5, 382},
+					{0xa6, 0xa6, 221},
+					{0xa7, 0xa7, 383},
+					{0xa8, 0xa8, 223},
+					{0xa9, 0xa9, 224},
+					{0xaa, 0xaa, 384},
+					{0xac, 0xac, 226},
+					{0xad, 0xad, 385},
+					{0xae, 0xae, 386},
+					{0xaf, 0xaf, 229},
+					{0xb0, 0xb0, 230},
+					{0xb1, 0xb1, 387},
+					{0xb3, 0xb3, 232},
+					{0xb4, 0xb6, 49},
+					{0xb8, 0xbb, 49},
+					{0xbc, 0xbc, 233},
+					{0xbd, 0xbd, 234},
+					{0xbe, 0xbe, 235},
+					{0xbf, 0xbf, 236},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x91, 49},
+					{0x92, 0x92, 256},
+					{0x93, 0x93, 257},
+					{0x94, 0x97, 49},
+					{0x98, 0x98, 388},
+					{0x99, 0x99, 259},
+					{0x9a, 0x9a, 260},
+					{0x9b, 0x9b, 229},
+					{0x9c, 0x9c, 261},
+					{0x9d, 0x9d, 49},
+					{0x9e, 0x9e, 262},
+					{0x9f, 0x9f, 263},
+					{0xa0, 0xa0, 264},
+					{0xa1, 0xa1, 214},
+					{0xa2, 0xa2, 265},
+					{0xa3, 0xa3, 389},
+					{0xa4, 0xa4, 390},
+					{0xa5, 0xa5, 268},
+					{0xa6, 0xa6, 269},
+					{0xa7, 0xa7, 391},
+					{0xa8, 0xa8, 271},
+					{0xa9, 0xa9, 392},
+					{0xaa, 0xaa, 273},
+					{0xab, 0xab, 274},
+					{0xac, 0xac, 275},
+					{0xaf, 0xaf, 393},
+					{0xb0, 0xbf, 49},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xa4, 0xa8, 49},
+					{0xa9, 0xa9, 279},
+					{0xaa, 0xaa, 49},
+					{0xab, 0xab, 280},
+					{0xac, 0xac, 281},
+					{0xad, 0xad, 282},
+					{0xae, 0xae, 283},
+					{0xaf, 0xaf, 284},
+					{0xb0, 0xb3, 49},
+					{0xb4, 0xb4, 285},
+					{0xb5, 0xb5, 286},
+					{0xb6, 0xb6, 287},
+					{0xb7, 0xb7, 288},
+					{0xb9, 0xb9, 289},
+					{0xba, 0xba, 49},
+					{0xbb, 0xbb, 290},
+					{0xbc, 0xbc, 394},
+					{0xbd, 0xbd, 292},
+					{0xbe, 0xbe, 293},
+					{0xbf, 0xbf, 294},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 395},
+					{0x91, 0x91, 396},
+					{0x92, 0x92, 297},
+					{0x93, 0x93, 298},
+					{0x96, 0x96, 397},
+					{0x9b, 0x9b, 300},
+					{0x9c, 0x9c, 398},
+					{0x9d, 0x9d, 399},
+					{0x9e, 0x9e, 400},
+					{0x9f, 0x9f, 401},
+					{0xa0, 0xa9, 71},
+					{0xaa, 0xaa, 303},
+					{0xab, 0xab, 304},
+					{0xaf, 0xaf, 305},
+				}},
+			{
+				Label: 32,
+			},
+			{
+				Label: 98,
+			},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 29}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 138}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 138}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 138},
+					{0xbb, 0xbb, 402},
+					{0xbc, 0xbf, 138},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 140}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 140}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 140}}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 403},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x72, 25},
+					{0x73, 0x73, 404},
+					{0x74, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 405},
+					{0x62, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6d, 25},
+					{0x6e, 0x6e, 406},
+					{0x6f, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x65, 25},
+					{0x66, 0x66, 407},
+					{0x67, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x72, 25},
+					{0x73, 0x73, 408},
+					{0x74, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6b, 25},
+					{0x6c, 0x6c, 409},
+					{0x6d, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 410},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6d, 25},
+					{0x6e, 0x6e, 411},
+					{0x6f, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 74,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 412},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 76,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6f, 25},
+					{0x70, 0x70, 413},
+					{0x71, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 414},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6f, 25},
+					{0x70, 0x70, 415},
+					{0x71, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x62, 25},
+					{0x63, 0x63, 416},
+					{0x64, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6d, 25},
+					{0x6e, 0x6e, 417},
+					{0x6f, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 418},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6b, 25},
+					{0x6c, 0x6c, 419},
+					{0x6d, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 420},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x68, 25},
+					{0x69, 0x69, 421},
+					{0x6a, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6f, 25},
+					{0x70, 0x70, 422},
+					{0x71, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 423},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 31,
+			},
+			{
+				Label: 37,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x95, 25},
+					{0x9a, 0x9a, 25},
+					{0xa4, 0xa4, 25},
+					{0xa8, 0xa8, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x98, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0xa0, 0xa0, 25},
+					{0xa2, 0xac, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x84, 0xb9, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 25},
+					{0x98, 0xa1, 25},
+					{0xb1, 0xb7, 25},
+					{0xb9, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x8c, 25},
+					{0x8f, 0x90, 25},
+					{0x93, 0xa8, 25},
+					{0xaa, 0xb0, 25},
+					{0xb2, 0xb2, 25},
+					{0xb6, 0xb9, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x8e, 0x8e, 25},
+					{0x9c, 0x9d, 25},
+					{0x9f, 0xa1, 25},
+					{0xb0, 0xb1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x8a, 25},
+					{0x8f, 0x90, 25},
+					{0x93, 0xa8, 25},
+					{0xaa, 0xb0, 25},
+					{0xb2, 0xb3, 25},
+					{0xb5, 0xb6, 25},
+					{0xb8, 0xb9, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x99, 0x9c, 25},
+					{0x9e, 0x9e, 25},
+					{0xb2, 0xb4, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x8d, 25},
+					{0x8f, 0x91, 25},
+					{0x93, 0xa8, 25},
+					{0xaa, 0xb0, 25},
+					{0xb2, 0xb3, 25},
+					{0xb5, 0xb9, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 25},
+					{0xa0, 0xa1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x8c, 25},
+					{0x8f, 0x90, 25},
+					{0x93, 0xa8, 25},
+					{0xaa, 0xb0, 25},
+					{0xb2, 0xb3, 25},
+					{0xb5, 0xb9, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x9c, 0x9d, 25},
+					{0x9f, 0xa1, 25},
+					{0xb1, 0xb1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x83, 0x83, 25},
+					{0x85, 0x8a, 25},
+					{0x8e, 0x90, 25},
+					{0x92, 0x95, 25},
+					{0x99, 0x9a, 25},
+					{0x9c, 0x9c, 25},
+					{0x9e, 0x9f, 25},
+					{0xa3, 0xa4, 25},
+					{0xa8, 0xaa, 25},
+					{0xae, 0xb9, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0x90, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x8c, 25},
+					{0x8e, 0x90, 25},
+					{0x92, 0xa8, 25},
+					{0xaa, 0xb3, 25},
+					{0xb5, 0xb9, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x98, 0x99, 25},
+					{0xa0, 0xa1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x9e, 0x9e, 25},
+					{0xa0, 0xa1, 25},
+					{0xb1, 0xb2, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x8c, 25},
+					{0x8e, 0x90, 25},
+					{0x92, 0xba, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x8e, 0x8e, 25},
+					{0xa0, 0xa1, 25},
+					{0xba, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x96, 25},
+					{0x9a, 0xb1, 25},
+					{0xb3, 0xbb, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x86, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0xb0, 25},
+					{0xb2, 0xb3, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0x82, 25},
+					{0x84, 0x84, 25},
+					{0x87, 0x88, 25},
+					{0x8a, 0x8a, 25},
+					{0x8d, 0x8d, 25},
+					{0x94, 0x97, 25},
+					{0x99, 0x9f, 25},
+					{0xa1, 0xa3, 25},
+					{0xa5, 0xa5, 25},
+					{0xa7, 0xa7, 25},
+					{0xaa, 0xab, 25},
+					{0xad, 0xb0, 25},
+					{0xb2, 0xb3, 25},
+					{0xbd, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x84, 25},
+					{0x86, 0x86, 25},
+					{0x9c, 0x9f, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x80, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x87, 25},
+					{0x89, 0xac, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x88, 0x8c, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xaa, 25},
+					{0xbf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x95, 25},
+					{0x9a, 0x9d, 25},
+					{0xa1, 0xa1, 25},
+					{0xa5, 0xa6, 25},
+					{0xae, 0xb0, 25},
+					{0xb5, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x81, 25},
+					{0x8e, 0x8e, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x85, 25},
+					{0x87, 0x87, 25},
+					{0x8d, 0x8d, 25},
+					{0x90, 0xba, 25},
+					{0xbc, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x88, 25},
+					{0x8a, 0x8d, 25},
+					{0x90, 0x96, 25},
+					{0x98, 0x98, 25},
+					{0x9a, 0x9d, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x88, 25},
+					{0x8a, 0x8d, 25},
+					{0x90, 0xb0, 25},
+					{0xb2, 0xb5, 25},
+					{0xb8, 0xbe, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 25},
+					{0x82, 0x85, 25},
+					{0x88, 0x96, 25},
+					{0x98, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x90, 25},
+					{0x92, 0x95, 25},
+					{0x98, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x9a, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8f, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xb4, 25}}},
+			{
+				Table: dfa.TransTable{{0x81, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xac, 25},
+					{0xaf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0x9a, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xaa, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8c, 25},
+					{0x8e, 0x91, 25},
+					{0xa0, 0xb1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x91, 25},
+					{0xa0, 0xac, 25},
+					{0xae, 0xb0, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xb3, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x97, 0x97, 25},
+					{0x9c, 0x9c, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xb7, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa8, 25},
+					{0xaa, 0xaa, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xb5, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x9c, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0xad, 25},
+					{0xb0, 0xb4, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xab, 25}}},
+			{
+				Table: dfa.TransTable{{0x81, 0x87, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x96, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x94, 25}}},
+			{
+				Table: dfa.TransTable{{0xa7, 0xa7, 25}}},
+			{
+				Table: dfa.TransTable{{0x85, 0xb3, 25}}},
+			{
+				Table: dfa.TransTable{{0x85, 0x8b, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x83, 0xa0, 25},
+					{0xae, 0xaf, 25},
+					{0xba, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xa5, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xa3, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x8d, 0x8f, 25},
+					{0x9a, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xa9, 0xac, 25},
+					{0xae, 0xb1, 25},
+					{0xb5, 0xb6, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x95, 25},
+					{0x98, 0x9d, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x85, 25},
+					{0x88, 0x8d, 25},
+					{0x90, 0x97, 25},
+					{0x99, 0x99, 25},
+					{0x9b, 0x9b, 25},
+					{0x9d, 0x9d, 25},
+					{0x9f, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xb4, 25},
+					{0xb6, 0xbc, 25},
+					{0xbe, 0xbe, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x82, 0x84, 25},
+					{0x86, 0x8c, 25},
+					{0x90, 0x93, 25},
+					{0x96, 0x9b, 25},
+					{0xa0, 0xac, 25},
+					{0xb2, 0xb4, 25},
+					{0xb6, 0xbc, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xb1, 0xb1, 25},
+					{0xbf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0x9c, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x82, 0x82, 25},
+					{0x87, 0x87, 25},
+					{0x8a, 0x93, 25},
+					{0x95, 0x95, 25},
+					{0x99, 0x9d, 25},
+					{0xa4, 0xa4, 25},
+					{0xa6, 0xa6, 25},
+					{0xa8, 0xa8, 25},
+					{0xaa, 0xad, 25},
+					{0xaf, 0xb9, 25},
+					{0xbc, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x89, 25},
+					{0x8e, 0x8e, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x83, 0x84, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xae, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9e, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa4, 25},
+					{0xab, 0xae, 25},
+					{0xb2, 0xb3, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa5, 25},
+					{0xa7, 0xa7, 25},
+					{0xad, 0xad, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa7, 25},
+					{0xaf, 0xaf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x96, 25},
+					{0xa0, 0xa6, 25},
+					{0xa8, 0xae, 25},
+					{0xb0, 0xb6, 25},
+					{0xb8, 0xbe, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x86, 25},
+					{0x88, 0x8e, 25},
+					{0x90, 0x96, 25},
+					{0x98, 0x9e, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xaf, 0xaf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x86, 25},
+					{0xb1, 0xb5, 25},
+					{0xbb, 0xbc, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x96, 25},
+					{0x9d, 0x9f, 25},
+					{0xa1, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 25},
+					{0xbc, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0xad, 25},
+					{0xb1, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8e, 25},
+					{0xa0, 0xba, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xb0, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8c, 25}}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbd, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8c, 25},
+					{0x90, 0x9f, 25},
+					{0xaa, 0xab, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xae, 25},
+					{0xbf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x97, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x97, 0x9f, 25},
+					{0xa2, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x88, 25},
+					{0x8b, 0x8e, 25},
+					{0x90, 0x93, 25},
+					{0xa0, 0xaa, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xb8, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x81, 25},
+					{0x83, 0x85, 25},
+					{0x87, 0x8a, 25},
+					{0x8c, 0xa2, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x82, 0xb3, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0xb2, 0xb7, 25},
+					{0xbb, 0xbb, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x8a, 0xa5, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x86, 25},
+					{0xa0, 0xbc, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x84, 0xb2, 25}}},
+			{
+				Table: dfa.TransTable{{0x8f, 0x8f, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xa8, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x82, 25},
+					{0x84, 0x8b, 25},
+					{0xa0, 0xb6, 25},
+					{0xba, 0xba, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xaf, 25},
+					{0xb1, 0xb1, 25},
+					{0xb5, 0xb6, 25},
+					{0xb9, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 25},
+					{0x82, 0x82, 25},
+					{0x9b, 0x9d, 25},
+					{0xa0, 0xaa, 25},
+					{0xb2, 0xb4, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0x86, 25},
+					{0x89, 0x8e, 25},
+					{0x91, 0x96, 25},
+					{0xa0, 0xa6, 25},
+					{0xa8, 0xae, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xa2, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa3, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x86, 25},
+					{0x8b, 0xbb, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xad, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x99, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x86, 25},
+					{0x93, 0x97, 25},
+					{0x9d, 0x9d, 25},
+					{0x9f, 0xa8, 25},
+					{0xaa, 0xb6, 25},
+					{0xb8, 0xbc, 25},
+					{0xbe, 0xbe, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x81, 25},
+					{0x83, 0x84, 25},
+					{0x86, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xb1, 25}}},
+			{
+				Table: dfa.TransTable{{0x93, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbd, 25}}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8f, 25},
+					{0x92, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x87, 25},
+					{0xb0, 0xbb, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xb0, 0xb4, 25},
+					{0xb6, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbc, 25}}},
+			{
+				Table: dfa.TransTable{{0xa1, 0xba, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0x9a, 25},
+					{0xa6, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x82, 0x87, 25},
+					{0x8a, 0x8f, 25},
+					{0x92, 0x97, 25},
+					{0x9a, 0x9c, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 424},
+					{0x81, 0x81, 425},
+					{0x82, 0x82, 49},
+					{0x83, 0x83, 426},
+					{0x8a, 0x8a, 427},
+					{0x8b, 0x8b, 428},
+					{0x8c, 0x8c, 429},
+					{0x8d, 0x8d, 430},
+					{0x8e, 0x8e, 431},
+					{0x8f, 0x8f, 432},
+					{0x90, 0x91, 49},
+					{0x92, 0x92, 433},
+					{0xa0, 0xa0, 434},
+					{0xa1, 0xa1, 435},
+					{0xa4, 0xa4, 436},
+					{0xa6, 0xa6, 437},
+					{0xa8, 0xa8, 438},
+					{0xa9, 0xa9, 439},
+					{0xac, 0xac, 218},
+					{0xad, 0xad, 440},
+					{0xb0, 0xb0, 49},
+					{0xb1, 0xb1, 441},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 442},
+					{0x82, 0x82, 443},
+					{0x83, 0x83, 444},
+					{0x84, 0x84, 445},
+					{0x86, 0x86, 446},
+					{0x87, 0x87, 447},
+					{0x9a, 0x9a, 211},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8c, 49},
+					{0x8d, 0x8d, 448},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8f, 49},
+					{0x90, 0x90, 448},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xa0, 0xa7, 49},
+					{0xa8, 0xa8, 449},
+					{0xbc, 0xbc, 49},
+					{0xbd, 0xbd, 450},
+					{0xbe, 0xbe, 451},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x80, 452}}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 49},
+					{0x91, 0x91, 453},
+					{0x92, 0x92, 454},
+					{0x93, 0x93, 455},
+					{0x94, 0x94, 456},
+					{0x95, 0x95, 457},
+					{0x96, 0x99, 49},
+					{0x9a, 0x9a, 458},
+					{0x9b, 0x9b, 459},
+					{0x9c, 0x9c, 460},
+					{0x9d, 0x9d, 461},
+					{0x9e, 0x9e, 462},
+					{0x9f, 0x9f, 463},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xb8, 0xb8, 464},
+					{0xb9, 0xb9, 465},
+					{0xba, 0xba, 466},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9a, 49},
+					{0x9b, 0x9b, 467},
+					{0x9c, 0xbf, 49},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9b, 49},
+					{0x9c, 0x9c, 207},
+					{0x9d, 0x9f, 49},
+					{0xa0, 0xa0, 433},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0xa0, 0xa7, 49},
+					{0xa8, 0xa8, 433},
+				}},
+			{
+				Table: dfa.TransTable{{0x30, 0x37, 468}}},
+			{
+				Table: dfa.TransTable{{0x30, 0x30, 469}}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 470},
+					{0x41, 0x46, 470},
+					{0x61, 0x66, 470},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 471},
+					{0x41, 0x46, 471},
+					{0x61, 0x66, 471},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 6}}},
+			{
+				Label: 35,
+			},
+			{
+				Label: 10,
+			},
+			{
+				Table: dfa.TransTable{{0x30, 0x37, 472}}},
+			{
+				Table: dfa.TransTable{{0x30, 0x30, 473}}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 474},
+					{0x41, 0x46, 474},
+					{0x61, 0x66, 474},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 475},
+					{0x41, 0x46, 475},
+					{0x61, 0x66, 475},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 91}}},
+			{
+				Label: 50,
+			},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2b, 0x2b, 476},
+					{0x2d, 0x2d, 476},
+					{0x30, 0x39, 477},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 106},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 478},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 112,
+				Table: dfa.TransTable{
+					{0x01, 0x29, 321},
+					{0x2a, 0x2a, 479},
+					{0x2b, 0x7f, 321},
+					{0xc2, 0xdf, 480},
+					{0xe0, 0xe0, 481},
+					{0xe1, 0xee, 482},
+					{0xef, 0xef, 483},
+					{0xf0, 0xf0, 484},
+					{0xf1, 0xf3, 485},
+					{0xf4, 0xf4, 486},
+				}},
+			{
+				Label: 112,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 107},
+					{0x0a, 0x0a, 321},
+					{0x0b, 0x29, 107},
+					{0x2a, 0x2a, 322},
+					{0x2b, 0x2e, 107},
+					{0x2f, 0x2f, 487},
+					{0x30, 0x7f, 107},
+					{0xc2, 0xdf, 323},
+					{0xe0, 0xe0, 324},
+					{0xe1, 0xee, 325},
+					{0xef, 0xef, 326},
+					{0xf0, 0xf0, 327},
+					{0xf1, 0xf3, 328},
+					{0xf4, 0xf4, 329},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 107}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 323}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 323}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 323},
+					{0xbb, 0xbb, 488},
+					{0xbc, 0xbf, 323},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 325}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 325}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 325}}},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 330},
+					{0x0a, 0x0a, 331},
+					{0x0b, 0x7f, 330},
+					{0xc2, 0xdf, 333},
+					{0xe0, 0xe0, 334},
+					{0xe1, 0xee, 335},
+					{0xef, 0xef, 336},
+					{0xf0, 0xf0, 337},
+					{0xf1, 0xf3, 338},
+					{0xf4, 0xf4, 339},
+				}},
+			{
+				Label: 93,
+			},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 330},
+					{0x0a, 0x0a, 331},
+					{0x0b, 0x68, 330},
+					{0x69, 0x69, 489},
+					{0x6a, 0x7f, 330},
+					{0xc2, 0xdf, 333},
+					{0xe0, 0xe0, 334},
+					{0xe1, 0xee, 335},
+					{0xef, 0xef, 336},
+					{0xf0, 0xf0, 337},
+					{0xf1, 0xf3, 338},
+					{0xf4, 0xf4, 339},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 330}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 333}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 333}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 333},
+					{0xbb, 0xbb, 490},
+					{0xbc, 0xbf, 333},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 335}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 335}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 335}}},
+			{
+				Label: 8,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 340},
+					{0x45, 0x45, 341},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 491},
+					{0x65, 0x65, 341},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2b, 0x2b, 492},
+					{0x2d, 0x2d, 492},
+					{0x30, 0x39, 493},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 342},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 342},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 342},
+					{0x30, 0x39, 112},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 494},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 344},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 344},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 348},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 7,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x31, 345},
+					{0x32, 0x39, 346},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 347},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x39, 346},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 346},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 348},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x31, 345},
+					{0x32, 0x39, 346},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 346},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 348},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+			},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 350},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 8,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 350},
+					{0x5f, 0x5f, 349},
+					{0x69, 0x69, 119},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 351},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 7,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x37, 352},
+					{0x38, 0x39, 353},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 354},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x39, 353},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 353},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 348},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 344},
+					{0x30, 0x37, 352},
+					{0x38, 0x39, 353},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 353},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 348},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 495},
+					{0x41, 0x46, 495},
+					{0x50, 0x50, 357},
+					{0x5f, 0x5f, 496},
+					{0x61, 0x66, 495},
+					{0x69, 0x69, 359},
+					{0x70, 0x70, 357},
+				}},
+			{
+				Label: 7,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 497},
+					{0x30, 0x39, 356},
+					{0x41, 0x46, 356},
+					{0x50, 0x50, 498},
+					{0x5f, 0x5f, 358},
+					{0x61, 0x66, 356},
+					{0x69, 0x69, 119},
+					{0x70, 0x70, 498},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2b, 0x2b, 499},
+					{0x2d, 0x2d, 499},
+					{0x30, 0x39, 499},
+					{0x5f, 0x5f, 499},
+					{0x69, 0x69, 359},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 496},
+					{0x30, 0x39, 356},
+					{0x41, 0x46, 356},
+					{0x50, 0x50, 357},
+					{0x5f, 0x5f, 500},
+					{0x61, 0x66, 356},
+					{0x69, 0x69, 359},
+					{0x70, 0x70, 357},
+				}},
+			{
+				Label: 111,
+			},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 342},
+					{0x30, 0x37, 360},
+					{0x38, 0x39, 494},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 360},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 122,
+			},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 342},
+					{0x30, 0x39, 362},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 362},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 33,
+			},
+			{
+				Label: 34,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 25},
+					{0x98, 0xa1, 25},
+					{0xa6, 0xaf, 25},
+					{0xb1, 0xb7, 25},
+					{0xb9, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x8e, 0x8e, 25},
+					{0x9c, 0x9d, 25},
+					{0x9f, 0xa1, 25},
+					{0xa6, 0xb1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x99, 0x9c, 25},
+					{0x9e, 0x9e, 25},
+					{0xa6, 0xaf, 25},
+					{0xb2, 0xb4, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 25},
+					{0xa0, 0xa1, 25},
+					{0xa6, 0xaf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x9c, 0x9d, 25},
+					{0x9f, 0xa1, 25},
+					{0xa6, 0xaf, 25},
+					{0xb1, 0xb1, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 25},
+					{0xa6, 0xaf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x98, 0x99, 25},
+					{0xa0, 0xa1, 25},
+					{0xa6, 0xaf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x9e, 0x9e, 25},
+					{0xa0, 0xa1, 25},
+					{0xa6, 0xaf, 25},
+					{0xb1, 0xb2, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x8e, 0x8e, 25},
+					{0xa0, 0xa1, 25},
+					{0xa6, 0xaf, 25},
+					{0xba, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x86, 25},
+					{0xa6, 0xaf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x86, 25},
+					{0x90, 0x99, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x84, 25},
+					{0x86, 0x86, 25},
+					{0x90, 0x99, 25},
+					{0x9c, 0x9f, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 25},
+					{0xa0, 0xa9, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x89, 25},
+					{0x90, 0x95, 25},
+					{0x9a, 0x9d, 25},
+					{0xa1, 0xa1, 25},
+					{0xa5, 0xa6, 25},
+					{0xae, 0xb0, 25},
+					{0xb5, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x81, 25},
+					{0x8e, 0x8e, 25},
+					{0x90, 0x99, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x97, 0x97, 25},
+					{0x9c, 0x9c, 25},
+					{0xa0, 0xa9, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x99, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x86, 0xad, 25},
+					{0xb0, 0xb4, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0x87, 25},
+					{0x90, 0x99, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x89, 25},
+					{0x90, 0x99, 25},
+					{0xa7, 0xa7, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x8b, 25},
+					{0x90, 0x99, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x83, 0xa0, 25},
+					{0xae, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x89, 25},
+					{0x8d, 0xbd, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8c, 25},
+					{0x90, 0xab, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x99, 25},
+					{0xb2, 0xb7, 25},
+					{0xbb, 0xbb, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa5, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x8f, 0x99, 25},
+					{0xb0, 0xb9, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x82, 25},
+					{0x84, 0x8b, 25},
+					{0x90, 0x99, 25},
+					{0xa0, 0xb6, 25},
+					{0xba, 0xba, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa2, 25},
+					{0xb0, 0xb9, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x99, 25},
+					{0xa1, 0xba, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 424},
+					{0x81, 0x81, 425},
+					{0x82, 0x82, 49},
+					{0x83, 0x83, 426},
+					{0x8a, 0x8a, 427},
+					{0x8b, 0x8b, 428},
+					{0x8c, 0x8c, 429},
+					{0x8d, 0x8d, 430},
+					{0x8e, 0x8e, 431},
+					{0x8f, 0x8f, 432},
+					{0x90, 0x91, 49},
+					{0x92, 0x92, 501},
+					{0xa0, 0xa0, 434},
+					{0xa1, 0xa1, 435},
+					{0xa4, 0xa4, 436},
+					{0xa6, 0xa6, 437},
+					{0xa8, 0xa8, 438},
+					{0xa9, 0xa9, 439},
+					{0xac, 0xac, 218},
+					{0xad, 0xad, 440},
+					{0xb0, 0xb0, 49},
+					{0xb1, 0xb1, 441},
+					{0xb4, 0xb4, 502},
+					{0xb5, 0xb5, 503},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 442},
+					{0x81, 0x81, 504},
+					{0x82, 0x82, 443},
+					{0x83, 0x83, 505},
+					{0x84, 0x84, 506},
+					{0x86, 0x86, 446},
+					{0x87, 0x87, 507},
+					{0x8b, 0x8b, 502},
+					{0x91, 0x91, 508},
+					{0x93, 0x93, 508},
+					{0x99, 0x99, 508},
+					{0x9a, 0x9a, 211},
+					{0x9b, 0x9b, 509},
+					{0x9c, 0x9c, 502},
+					{0xa3, 0xa3, 510},
+					{0xa5, 0xa5, 508},
+					{0xaf, 0xaf, 502},
+					{0xb1, 0xb1, 508},
+					{0xb5, 0xb5, 508},
+					{0xb6, 0xb7, 510},
+					{0xbd, 0xbd, 508},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x84, 0x84, 502},
+					{0xa0, 0xa7, 49},
+					{0xa8, 0xa8, 449},
+					{0xa9, 0xa9, 510},
+					{0xab, 0xab, 503},
+					{0xad, 0xad, 508},
+					{0xb5, 0xb5, 502},
+					{0xbc, 0xbc, 49},
+					{0xbd, 0xbd, 450},
+					{0xbe, 0xbe, 451},
+				}},
+			{
+				Table: dfa.TransTable{{0xb3, 0xb3, 502}}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0x90, 49},
+					{0x91, 0x91, 453},
+					{0x92, 0x92, 454},
+					{0x93, 0x93, 455},
+					{0x94, 0x94, 456},
+					{0x95, 0x95, 457},
+					{0x96, 0x99, 49},
+					{0x9a, 0x9a, 458},
+					{0x9b, 0x9b, 459},
+					{0x9c, 0x9c, 460},
+					{0x9d, 0x9d, 461},
+					{0x9e, 0x9e, 462},
+					{0x9f, 0x9f, 511},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x85, 0x85, 503},
+					{0x8b, 0x8b, 502},
+					{0x93, 0x93, 502},
+					{0x97, 0x97, 512},
+					{0xa5, 0xa5, 508},
+					{0xb8, 0xb8, 464},
+					{0xb9, 0xb9, 465},
+					{0xba, 0xba, 466},
+				}},
+			{
+				Table: dfa.TransTable{{0xaf, 0xaf, 502}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 29}}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 513},
+					{0x62, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 514},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6d, 25},
+					{0x6e, 0x6e, 515},
+					{0x6f, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x72, 25},
+					{0x73, 0x73, 516},
+					{0x74, 0x74, 517},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 518},
+					{0x62, 0x64, 25},
+					{0x65, 0x65, 519},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 520},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6b, 25},
+					{0x6c, 0x6c, 521},
+					{0x6d, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 72,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x62, 25},
+					{0x63, 0x63, 522},
+					{0x64, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6e, 25},
+					{0x6f, 0x6f, 523},
+					{0x70, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6e, 25},
+					{0x6f, 0x6f, 524},
+					{0x70, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 525},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 79,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6a, 25},
+					{0x6b, 0x6b, 526},
+					{0x6c, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x66, 25},
+					{0x67, 0x67, 527},
+					{0x68, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x74, 25},
+					{0x75, 0x75, 528},
+					{0x76, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 529},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x74, 25},
+					{0x75, 0x75, 530},
+					{0x76, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 531},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 532},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 87,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8b, 25},
+					{0x8d, 0xa6, 25},
+					{0xa8, 0xba, 25},
+					{0xbc, 0xbd, 25},
+					{0xbf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8d, 25},
+					{0x90, 0x9d, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xba, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9c, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x90, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9e, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 25},
+					{0x82, 0x89, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9d, 25},
+					{0xa0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x83, 25},
+					{0x88, 0x8f, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x9d, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x85, 25},
+					{0x88, 0x88, 25},
+					{0x8a, 0xb5, 25},
+					{0xb7, 0xb8, 25},
+					{0xbc, 0xbc, 25},
+					{0xbf, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x95, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x95, 25},
+					{0xa0, 0xb9, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xb7, 25},
+					{0xbe, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 25},
+					{0x90, 0x93, 25},
+					{0x95, 0x97, 25},
+					{0x99, 0xb3, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbc, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x95, 25},
+					{0xa0, 0xb2, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x88, 25}}},
+			{
+				Table: dfa.TransTable{{0x83, 0xb7, 25}}},
+			{
+				Table: dfa.TransTable{{0x83, 0xaf, 25}}},
+			{
+				Table: dfa.TransTable{{0x90, 0xa8, 25}}},
+			{
+				Table: dfa.TransTable{{0x83, 0xa6, 25}}},
+			{
+				Table: dfa.TransTable{{0x83, 0xb2, 25}}},
+			{
+				Table: dfa.TransTable{{0x81, 0x84, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xae, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xb8, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x84, 25},
+					{0x90, 0x90, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x93, 0x9f, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x81, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x94, 25},
+					{0x96, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9c, 25},
+					{0x9e, 0x9f, 25},
+					{0xa2, 0xa2, 25},
+					{0xa5, 0xa6, 25},
+					{0xa9, 0xac, 25},
+					{0xae, 0xb9, 25},
+					{0xbb, 0xbb, 25},
+					{0xbd, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x83, 25},
+					{0x85, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x85, 25},
+					{0x87, 0x8a, 25},
+					{0x8d, 0x94, 25},
+					{0x96, 0x9c, 25},
+					{0x9e, 0xb9, 25},
+					{0xbb, 0xbe, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x84, 25},
+					{0x86, 0x86, 25},
+					{0x8a, 0x90, 25},
+					{0x92, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xa5, 25},
+					{0xa8, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x80, 25},
+					{0x82, 0x9a, 25},
+					{0x9c, 0xba, 25},
+					{0xbc, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x94, 25},
+					{0x96, 0xb4, 25},
+					{0xb6, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x8e, 25},
+					{0x90, 0xae, 25},
+					{0xb0, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x88, 25},
+					{0x8a, 0xa8, 25},
+					{0xaa, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x82, 25},
+					{0x84, 0x8b, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x83, 25},
+					{0x85, 0x9f, 25},
+					{0xa1, 0xa2, 25},
+					{0xa4, 0xa4, 25},
+					{0xa7, 0xa7, 25},
+					{0xa9, 0xb2, 25},
+					{0xb4, 0xb7, 25},
+					{0xb9, 0xb9, 25},
+					{0xbb, 0xbb, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x82, 0x82, 25},
+					{0x87, 0x87, 25},
+					{0x89, 0x89, 25},
+					{0x8b, 0x8b, 25},
+					{0x8d, 0x8f, 25},
+					{0x91, 0x92, 25},
+					{0x94, 0x94, 25},
+					{0x97, 0x97, 25},
+					{0x99, 0x99, 25},
+					{0x9b, 0x9b, 25},
+					{0x9d, 0x9d, 25},
+					{0x9f, 0x9f, 25},
+					{0xa1, 0xa2, 25},
+					{0xa4, 0xa4, 25},
+					{0xa7, 0xaa, 25},
+					{0xac, 0xb2, 25},
+					{0xb4, 0xb7, 25},
+					{0xb9, 0xbc, 25},
+					{0xbe, 0xbe, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x89, 25},
+					{0x8b, 0x9b, 25},
+					{0xa1, 0xa3, 25},
+					{0xa5, 0xa9, 25},
+					{0xab, 0xbb, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0x96, 25}}},
+			{
+				Table: dfa.TransTable{{0x30, 0x37, 6}}},
+			{
+				Table: dfa.TransTable{{0x30, 0x30, 533}}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 309},
+					{0x41, 0x46, 309},
+					{0x61, 0x66, 309},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 6},
+					{0x41, 0x46, 6},
+					{0x61, 0x66, 6},
+				}},
+			{
+				Table: dfa.TransTable{{0x30, 0x37, 91}}},
+			{
+				Table: dfa.TransTable{{0x30, 0x30, 534}}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 316},
+					{0x41, 0x46, 316},
+					{0x61, 0x66, 316},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 91},
+					{0x41, 0x46, 91},
+					{0x61, 0x66, 91},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 477},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 8,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 477},
+					{0x5f, 0x5f, 476},
+					{0x69, 0x69, 119},
+				}},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 478},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 478},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 112,
+				Table: dfa.TransTable{
+					{0x01, 0x29, 321},
+					{0x2a, 0x2a, 479},
+					{0x2b, 0x2e, 321},
+					{0x2f, 0x2f, 535},
+					{0x30, 0x7f, 321},
+					{0xc2, 0xdf, 480},
+					{0xe0, 0xe0, 481},
+					{0xe1, 0xee, 482},
+					{0xef, 0xef, 483},
+					{0xf0, 0xf0, 484},
+					{0xf1, 0xf3, 485},
+					{0xf4, 0xf4, 486},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 321}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 480}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 480}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 480},
+					{0xbb, 0xbb, 536},
+					{0xbc, 0xbf, 480},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 482}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 482}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 482}}},
+			{
+				Label: 96,
+			},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 107}}},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 330},
+					{0x0a, 0x0a, 331},
+					{0x0b, 0x6d, 330},
+					{0x6e, 0x6e, 537},
+					{0x6f, 0x7f, 330},
+					{0xc2, 0xdf, 333},
+					{0xe0, 0xe0, 334},
+					{0xe1, 0xee, 335},
+					{0xef, 0xef, 336},
+					{0xf0, 0xf0, 337},
+					{0xf1, 0xf3, 338},
+					{0xf4, 0xf4, 339},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 330}}},
+			{
+				Label: 122,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 340},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 342},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 493},
+					{0x5f, 0x5f, 351},
+					{0x69, 0x69, 348},
+				}},
+			{
+				Label: 8,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 493},
+					{0x5f, 0x5f, 492},
+					{0x69, 0x69, 119},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 342},
+					{0x30, 0x39, 494},
+					{0x45, 0x45, 116},
+					{0x50, 0x50, 116},
+					{0x5f, 0x5f, 494},
+					{0x65, 0x65, 116},
+					{0x69, 0x69, 361},
+					{0x70, 0x70, 116},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 495},
+					{0x41, 0x46, 495},
+					{0x50, 0x50, 498},
+					{0x5f, 0x5f, 355},
+					{0x61, 0x66, 495},
+					{0x69, 0x69, 359},
+					{0x70, 0x70, 498},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 496},
+					{0x41, 0x46, 496},
+					{0x50, 0x50, 357},
+					{0x5f, 0x5f, 496},
+					{0x61, 0x66, 496},
+					{0x69, 0x69, 359},
+					{0x70, 0x70, 357},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 495},
+					{0x41, 0x46, 495},
+					{0x50, 0x50, 498},
+					{0x5f, 0x5f, 496},
+					{0x61, 0x66, 495},
+					{0x69, 0x69, 359},
+					{0x70, 0x70, 498},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2b, 0x2b, 538},
+					{0x2d, 0x2d, 538},
+					{0x30, 0x39, 539},
+					{0x5f, 0x5f, 499},
+					{0x69, 0x69, 359},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 499},
+					{0x5f, 0x5f, 499},
+					{0x69, 0x69, 359},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x2e, 0x2e, 496},
+					{0x30, 0x39, 500},
+					{0x41, 0x46, 500},
+					{0x50, 0x50, 357},
+					{0x5f, 0x5f, 500},
+					{0x61, 0x66, 500},
+					{0x69, 0x69, 359},
+					{0x70, 0x70, 357},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x9d, 25},
+					{0xa0, 0xa9, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xb0, 0xb9, 25}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x89, 25}}},
+			{
+				Table: dfa.TransTable{{0xa6, 0xaf, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x90, 0xa8, 25},
+					{0xb0, 0xb9, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x83, 0xa6, 25},
+					{0xb6, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x81, 0x84, 25},
+					{0x90, 0x99, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0x99, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x89, 25},
+					{0x90, 0xa3, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xa9, 25}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0x82, 25},
+					{0x84, 0x8b, 25},
+					{0x8e, 0xbf, 25},
+				}},
+			{
+				Table: dfa.TransTable{{0xb1, 0xba, 25}}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6a, 25},
+					{0x6b, 0x6b, 540},
+					{0x6c, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 64,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 65,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 541},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x68, 25},
+					{0x69, 0x69, 542},
+					{0x6a, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x74, 25},
+					{0x75, 0x75, 543},
+					{0x76, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 544},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 70,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 545},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 73,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 75,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 546},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 547},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 548},
+					{0x62, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 549},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 550},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x62, 25},
+					{0x63, 0x63, 551},
+					{0x64, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x62, 25},
+					{0x63, 0x63, 552},
+					{0x64, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x62, 25},
+					{0x63, 0x63, 553},
+					{0x64, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 86,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x30, 554},
+					{0x31, 0x31, 555},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x30, 556},
+					{0x31, 0x31, 557},
+				}},
+			{
+				Label: 97,
+			},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 321}}},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 330},
+					{0x0a, 0x0a, 331},
+					{0x0b, 0x64, 330},
+					{0x65, 0x65, 558},
+					{0x66, 0x7f, 330},
+					{0xc2, 0xdf, 333},
+					{0xe0, 0xe0, 334},
+					{0xe1, 0xee, 335},
+					{0xef, 0xef, 336},
+					{0xf0, 0xf0, 337},
+					{0xf1, 0xf3, 338},
+					{0xf4, 0xf4, 339},
+				}},
+			{
+				Label: 111,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 539},
+					{0x5f, 0x5f, 499},
+					{0x69, 0x69, 359},
+				}},
+			{
+				Label: 8,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 539},
+					{0x5f, 0x5f, 538},
+					{0x69, 0x69, 119},
+				}},
+			{
+				Label: 63,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 66,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6d, 25},
+					{0x6e, 0x6e, 559},
+					{0x6f, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6b, 25},
+					{0x6c, 0x6c, 560},
+					{0x6d, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 69,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x67, 25},
+					{0x68, 0x68, 561},
+					{0x69, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 562},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x65, 25},
+					{0x66, 0x66, 563},
+					{0x67, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x66, 25},
+					{0x67, 0x67, 564},
+					{0x68, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 81,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6d, 25},
+					{0x6e, 0x6e, 565},
+					{0x6f, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 566},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 567},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x67, 25},
+					{0x68, 0x68, 568},
+					{0x69, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 308},
+					{0x41, 0x46, 308},
+					{0x61, 0x66, 308},
+				}},
+			{
+				Table: dfa.TransTable{{0x30, 0x30, 308}}},
+			{
+				Table: dfa.TransTable{
+					{0x30, 0x39, 315},
+					{0x41, 0x46, 315},
+					{0x61, 0x66, 315},
+				}},
+			{
+				Table: dfa.TransTable{{0x30, 0x30, 315}}},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 330},
+					{0x0a, 0x0a, 331},
+					{0x0b, 0x1f, 330},
+					{0x20, 0x20, 569},
+					{0x21, 0x7f, 330},
+					{0xc2, 0xdf, 333},
+					{0xe0, 0xe0, 334},
+					{0xe1, 0xee, 335},
+					{0xef, 0xef, 336},
+					{0xf0, 0xf0, 337},
+					{0xf1, 0xf3, 338},
+					{0xf4, 0xf4, 339},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x74, 25},
+					{0x75, 0x75, 570},
+					{0x76, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x73, 25},
+					{0x74, 0x74, 571},
+					{0x75, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x71, 25},
+					{0x72, 0x72, 572},
+					{0x73, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 77,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x61, 573},
+					{0x62, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 574},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 82,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 83,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 84,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 85,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 575},
+					{0x0a, 0x0a, 331},
+					{0x0b, 0x7f, 575},
+					{0xc2, 0xdf, 576},
+					{0xe0, 0xe0, 577},
+					{0xe1, 0xee, 578},
+					{0xef, 0xef, 579},
+					{0xf0, 0xf0, 580},
+					{0xf1, 0xf3, 581},
+					{0xf4, 0xf4, 582},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 583},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 68,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x6e, 25},
+					{0x6f, 0x6f, 584},
+					{0x70, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x62, 25},
+					{0x63, 0x63, 585},
+					{0x64, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 80,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 94,
+				Table: dfa.TransTable{
+					{0x01, 0x09, 575},
+					{0x0a, 0x0a, 586},
+					{0x0b, 0x7f, 575},
+					{0xc2, 0xdf, 576},
+					{0xe0, 0xe0, 577},
+					{0xe1, 0xee, 578},
+					{0xef, 0xef, 579},
+					{0xf0, 0xf0, 580},
+					{0xf1, 0xf3, 581},
+					{0xf4, 0xf4, 582},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 575}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 576}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 576}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 576},
+					{0xbb, 0xbb, 587},
+					{0xbc, 0xbf, 576},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 578}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 578}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 578}}},
+			{
+				Label: 67,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x74, 25},
+					{0x75, 0x75, 588},
+					{0x76, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x64, 25},
+					{0x65, 0x65, 589},
+					{0x66, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 95,
+			},
+			{
+				Table: dfa.TransTable{{0x80, 0xbe, 575}}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x66, 25},
+					{0x67, 0x67, 590},
+					{0x68, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 78,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 6,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x67, 25},
+					{0x68, 0x68, 591},
+					{0x69, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+			{
+				Label: 71,
+				Table: dfa.TransTable{
+					{0x30, 0x39, 25},
+					{0x41, 0x5a, 25},
+					{0x5f, 0x5f, 25},
+					{0x61, 0x7a, 25},
+					{0xc2, 0xc2, 47},
+					{0xc3, 0xc3, 48},
+					{0xc4, 0xca, 49},
+					{0xcb, 0xcb, 50},
+					{0xcd, 0xcd, 51},
+					{0xce, 0xce, 52},
+					{0xcf, 0xcf, 53},
+					{0xd0, 0xd1, 49},
+					{0xd2, 0xd2, 54},
+					{0xd3, 0xd3, 49},
+					{0xd4, 0xd4, 55},
+					{0xd5, 0xd5, 56},
+					{0xd6, 0xd6, 57},
+					{0xd7, 0xd7, 58},
+					{0xd8, 0xd8, 59},
+					{0xd9, 0xd9, 128},
+					{0xda, 0xda, 49},
+					{0xdb, 0xdb, 129},
+					{0xdc, 0xdc, 62},
+					{0xdd, 0xdd, 63},
+					{0xde, 0xde, 64},
+					{0xdf, 0xdf, 130},
+					{0xe0, 0xe0, 131},
+					{0xe1, 0xe1, 132},
+					{0xe2, 0xe2, 68},
+					{0xe3, 0xe3, 69},
+					{0xe4, 0xe4, 70},
+					{0xe5, 0xe8, 71},
+					{0xe9, 0xe9, 72},
+					{0xea, 0xea, 133},
+					{0xeb, 0xec, 71},
+					{0xed, 0xed, 74},
+					{0xef, 0xef, 134},
+					{0xf0, 0xf0, 135},
+				}},
+		}}}
+
+var errMatcherCache = &scan.Matcher{
+	EOF:     99,
+	Illegal: 98,
+	M: &dfa.M{
+		States: dfa.States{
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x00, 1},
+					{0x22, 0x22, 2},
+					{0x27, 0x27, 3},
+					{0x2f, 0x2f, 4},
+					{0x60, 0x60, 5},
+					{0x80, 0xc1, 6},
+					{0xc2, 0xdf, 7},
+					{0xe0, 0xe0, 8},
+					{0xe1, 0xee, 9},
+					{0xef, 0xef, 10},
+					{0xf0, 0xf0, 11},
+					{0xf1, 0xf3, 12},
+					{0xf4, 0xf4, 13},
+					{0xf5, 0xff, 6},
+				}},
+			{
+				Label: 106,
+			},
+			{
+				Label: 115,
+				Table: dfa.TransTable{
+					{0x00, 0x00, 14},
+					{0x01, 0x09, 2},
+					{0x0b, 0x21, 2},
+					{0x23, 0x5b, 2},
+					{0x5c, 0x5c, 15},
+					{0x5d, 0x7f, 2},
+					{0x80, 0xc1, 16},
+					{0xc2, 0xdf, 17},
+					{0xe0, 0xe0, 18},
+					{0xe1, 0xee, 19},
+					{0xef, 0xef, 20},
+					{0xf0, 0xf0, 21},
+					{0xf1, 0xf3, 22},
+					{0xf4, 0xf4, 23},
+					{0xf5, 0xff, 16},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x00, 24},
+					{0x01, 0x09, 25},
+					{0x0b, 0x26, 25},
+					{0x27, 0x27, 26},
+					{0x28, 0x5b, 25},
+					{0x5c, 0x5c, 27},
+					{0x5d, 0x7f, 25},
+					{0x80, 0xc1, 28},
+					{0xc2, 0xdf, 29},
+					{0xe0, 0xe0, 30},
+					{0xe1, 0xee, 31},
+					{0xef, 0xef, 32},
+					{0xf0, 0xf0, 33},
+					{0xf1, 0xf3, 34},
+					{0xf4, 0xf4, 35},
+					{0xf5, 0xff, 28},
+				}},
+			{
+				Table: dfa.TransTable{{0x2f, 0x2f, 36}}},
+			{
+				Label: 116,
+				Table: dfa.TransTable{
+					{0x01, 0x5f, 5},
+					{0x61, 0x7f, 5},
+					{0xc2, 0xdf, 37},
+					{0xe0, 0xe0, 38},
+					{0xe1, 0xee, 39},
+					{0xef, 0xef, 40},
+					{0xf0, 0xf0, 41},
+					{0xf1, 0xf3, 42},
+					{0xf4, 0xf4, 43},
+				}},
+			{
+				Label: 119,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 6},
+					{0xc0, 0xff, 6},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x9f, 6},
+					{0xa0, 0xbf, 7},
+					{0xc0, 0xff, 6},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 6},
+					{0x80, 0xbf, 7},
+					{0xc0, 0xff, 6},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 6},
+					{0x80, 0xba, 7},
+					{0xbb, 0xbb, 44},
+					{0xbc, 0xbf, 7},
+					{0xc0, 0xff, 6},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x8f, 6},
+					{0x90, 0xbf, 9},
+					{0xc0, 0xff, 6},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 6},
+					{0x80, 0xbf, 9},
+					{0xc0, 0xff, 6},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 6},
+					{0x80, 0x8f, 9},
+					{0x90, 0xff, 6},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 14},
+					{0x0b, 0x21, 14},
+					{0x22, 0x22, 45},
+					{0x23, 0xff, 14},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x21, 46},
+					{0x22, 0x22, 2},
+					{0x23, 0x26, 46},
+					{0x27, 0x27, 2},
+					{0x28, 0x2f, 46},
+					{0x30, 0x37, 47},
+					{0x38, 0x54, 46},
+					{0x55, 0x55, 48},
+					{0x56, 0x5b, 46},
+					{0x5c, 0x5c, 2},
+					{0x5d, 0x60, 46},
+					{0x61, 0x62, 2},
+					{0x63, 0x65, 46},
+					{0x66, 0x66, 2},
+					{0x67, 0x6d, 46},
+					{0x6e, 0x6e, 2},
+					{0x6f, 0x71, 46},
+					{0x72, 0x72, 2},
+					{0x73, 0x73, 46},
+					{0x74, 0x74, 2},
+					{0x75, 0x75, 49},
+					{0x76, 0x76, 2},
+					{0x77, 0x77, 46},
+					{0x78, 0x78, 50},
+					{0x79, 0xff, 46},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 16},
+					{0x0b, 0x21, 16},
+					{0x22, 0x22, 51},
+					{0x23, 0xff, 16},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 16},
+					{0x80, 0xbf, 2},
+					{0xc0, 0xff, 16},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x9f, 16},
+					{0xa0, 0xbf, 17},
+					{0xc0, 0xff, 16},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 16},
+					{0x80, 0xbf, 17},
+					{0xc0, 0xff, 16},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 16},
+					{0x80, 0xba, 17},
+					{0xbb, 0xbb, 52},
+					{0xbc, 0xbf, 17},
+					{0xc0, 0xff, 16},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x8f, 16},
+					{0x90, 0xbf, 19},
+					{0xc0, 0xff, 16},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 16},
+					{0x80, 0xbf, 19},
+					{0xc0, 0xff, 16},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 16},
+					{0x80, 0x8f, 19},
+					{0x90, 0xff, 16},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 24},
+					{0x0b, 0x26, 24},
+					{0x28, 0x5b, 24},
+					{0x5d, 0xff, 24},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 53},
+					{0x0b, 0x26, 53},
+					{0x28, 0x5b, 53},
+					{0x5c, 0x5c, 54},
+					{0x5d, 0xff, 53},
+				}},
+			{
+				Label: 118,
+			},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x21, 55},
+					{0x22, 0x22, 56},
+					{0x23, 0x26, 55},
+					{0x27, 0x27, 57},
+					{0x28, 0x2f, 55},
+					{0x30, 0x37, 58},
+					{0x38, 0x54, 55},
+					{0x55, 0x55, 59},
+					{0x56, 0x5b, 55},
+					{0x5c, 0x5c, 56},
+					{0x5d, 0x60, 55},
+					{0x61, 0x62, 56},
+					{0x63, 0x65, 55},
+					{0x66, 0x66, 56},
+					{0x67, 0x6d, 55},
+					{0x6e, 0x6e, 56},
+					{0x6f, 0x71, 55},
+					{0x72, 0x72, 56},
+					{0x73, 0x73, 55},
+					{0x74, 0x74, 56},
+					{0x75, 0x75, 60},
+					{0x76, 0x76, 56},
+					{0x77, 0x77, 55},
+					{0x78, 0x78, 61},
+					{0x79, 0xff, 55},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 24},
+					{0x0b, 0x26, 24},
+					{0x27, 0x27, 62},
+					{0x28, 0x5b, 24},
+					{0x5d, 0xff, 24},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x7f, 28},
+					{0x80, 0xbf, 25},
+					{0xc0, 0xff, 28},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x9f, 28},
+					{0xa0, 0xbf, 29},
+					{0xc0, 0xff, 28},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x7f, 28},
+					{0x80, 0xbf, 29},
+					{0xc0, 0xff, 28},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x7f, 28},
+					{0x80, 0xba, 29},
+					{0xbb, 0xbb, 64},
+					{0xbc, 0xbf, 29},
+					{0xc0, 0xff, 28},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x8f, 28},
+					{0x90, 0xbf, 31},
+					{0xc0, 0xff, 28},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x7f, 28},
+					{0x80, 0xbf, 31},
+					{0xc0, 0xff, 28},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x7f, 28},
+					{0x80, 0x8f, 31},
+					{0x90, 0xff, 28},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x01, 0x09, 36},
+					{0x0b, 0x7f, 36},
+					{0xc2, 0xdf, 65},
+					{0xe0, 0xe0, 66},
+					{0xe1, 0xee, 67},
+					{0xef, 0xef, 68},
+					{0xf0, 0xf0, 69},
+					{0xf1, 0xf3, 70},
+					{0xf4, 0xf4, 71},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 5}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 37}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 37}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 37},
+					{0xbb, 0xbb, 72},
+					{0xbc, 0xbf, 37},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 39}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 39}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 39}}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 6},
+					{0xbf, 0xbf, 73},
+					{0xc0, 0xff, 6},
+				}},
+			{
+				Label: 107,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 46},
+					{0x0b, 0x21, 46},
+					{0x22, 0x22, 74},
+					{0x23, 0xff, 46},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x37, 77},
+					{0x38, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x30, 78},
+					{0x31, 0x39, 79},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 79},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 79},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 80},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 80},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 80},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 81},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 81},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 81},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Label: 121,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x7f, 16},
+					{0x80, 0xbe, 2},
+					{0xbf, 0xbf, 82},
+					{0xc0, 0xff, 16},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 53},
+					{0x0b, 0x26, 53},
+					{0x27, 0x27, 26},
+					{0x28, 0x5b, 53},
+					{0x5c, 0x5c, 54},
+					{0x5d, 0xff, 53},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 54},
+					{0x0b, 0x26, 54},
+					{0x27, 0x27, 26},
+					{0x28, 0xff, 54},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 55},
+					{0x27, 0x27, 83},
+					{0x28, 0xff, 55},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 84},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x26, 84},
+					{0x28, 0xff, 84},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 54},
+					{0x0b, 0x26, 54},
+					{0x28, 0xff, 54},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x37, 88},
+					{0x38, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x30, 89},
+					{0x31, 0x39, 90},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 90},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 90},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x39, 91},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 91},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 91},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x39, 92},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 92},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 92},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 120,
+			},
+			{
+				Table: dfa.TransTable{{0x27, 0x27, 62}}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 28},
+					{0x0a, 0x0a, 63},
+					{0x0b, 0x26, 28},
+					{0x27, 0x27, 63},
+					{0x28, 0x5b, 28},
+					{0x5c, 0x5c, 63},
+					{0x5d, 0x7f, 28},
+					{0x80, 0xbe, 25},
+					{0xbf, 0xbf, 93},
+					{0xc0, 0xff, 28},
+				}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 36}}},
+			{
+				Table: dfa.TransTable{{0xa0, 0xbf, 65}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 65}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xba, 65},
+					{0xbb, 0xbb, 94},
+					{0xbc, 0xbf, 65},
+				}},
+			{
+				Table: dfa.TransTable{{0x90, 0xbf, 67}}},
+			{
+				Table: dfa.TransTable{{0x80, 0xbf, 67}}},
+			{
+				Table: dfa.TransTable{{0x80, 0x8f, 67}}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xbe, 5},
+					{0xbf, 0xbf, 95},
+				}},
+			{
+				Label: 102,
+			},
+			{
+				Label: 110,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0xff, 75},
+				}},
+			{
+				Label: 108,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x37, 2},
+					{0x38, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x30, 96},
+					{0x31, 0x39, 97},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 97},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 97},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 97},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 97},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 97},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 50},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 50},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 50},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 2},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 2},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 2},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 82},
+					{0x0b, 0x21, 82},
+					{0x22, 0x22, 98},
+					{0x23, 0xff, 82},
+				}},
+			{
+				Label: 110,
+			},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 84},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x26, 84},
+					{0x27, 0x27, 26},
+					{0x28, 0xff, 84},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x28, 0xff, 85},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x27, 0x27, 87},
+					{0x28, 0xff, 85},
+				}},
+			{
+				Label: 108,
+			},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x37, 56},
+					{0x38, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x30, 99},
+					{0x31, 0x39, 100},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 100},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 100},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 101},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 101},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 101},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x39, 61},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 61},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 61},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x39, 56},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 56},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 56},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 113,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 24},
+					{0x0b, 0x26, 24},
+					{0x27, 0x27, 102},
+					{0x28, 0x5b, 24},
+					{0x5d, 0xff, 24},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x80, 0xbe, 36},
+					{0xbf, 0xbf, 103},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x5f, 95},
+					{0x60, 0x60, 104},
+					{0x61, 0xff, 95},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x30, 105},
+					{0x31, 0x31, 106},
+					{0x32, 0x39, 107},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 107},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 107},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 107},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 107},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 107},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Label: 105,
+			},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x30, 108},
+					{0x31, 0x31, 109},
+					{0x32, 0x39, 110},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 110},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 110},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 111},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 111},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 111},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 111},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 111},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 111},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Label: 104,
+			},
+			{
+				Label: 103,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 103},
+					{0x0a, 0x0a, 112},
+					{0x0b, 0xff, 103},
+				}},
+			{
+				Label: 105,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 49},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 49},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 49},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x30, 49},
+					{0x31, 0x39, 113},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 113},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 113},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 113},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 113},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 113},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x39, 60},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 60},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 60},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x09, 86},
+					{0x0a, 0x0a, 85},
+					{0x0b, 0x21, 86},
+					{0x22, 0x22, 85},
+					{0x23, 0x26, 86},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 86},
+					{0x30, 0x30, 60},
+					{0x31, 0x39, 114},
+					{0x3a, 0x40, 86},
+					{0x41, 0x46, 114},
+					{0x47, 0x60, 86},
+					{0x61, 0x66, 114},
+					{0x67, 0xff, 86},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 115},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 115},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 115},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 115},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 115},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 115},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Label: 103,
+			},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 116},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 116},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 116},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x27, 0x27, 87},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 117},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 117},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 117},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 117},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 117},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 117},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 118},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 118},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 118},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 119},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 119},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 119},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 120},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 120},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 120},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 121},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 121},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 121},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 75},
+					{0x0b, 0x21, 75},
+					{0x22, 0x22, 76},
+					{0x23, 0x2f, 75},
+					{0x30, 0x39, 122},
+					{0x3a, 0x40, 75},
+					{0x41, 0x46, 122},
+					{0x47, 0x60, 75},
+					{0x61, 0x66, 122},
+					{0x67, 0xff, 75},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x28, 0x2f, 85},
+					{0x30, 0x39, 123},
+					{0x3a, 0x40, 85},
+					{0x41, 0x46, 123},
+					{0x47, 0x60, 85},
+					{0x61, 0x66, 123},
+					{0x67, 0xff, 85},
+				}},
+			{
+				Table: dfa.TransTable{
+					{0x00, 0x09, 122},
+					{0x0b, 0x21, 122},
+					{0x22, 0x22, 124},
+					{0x23, 0xff, 122},
+				}},
+			{
+				Label: 114,
+				Table: dfa.TransTable{
+					{0x00, 0x26, 85},
+					{0x27, 0x27, 125},
+					{0x28, 0xff, 85},
+				}},
+			{
+				Label: 109,
+			},
+			{
+				Label: 109,
+			},
+		}}}
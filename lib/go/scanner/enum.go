@@ -91,12 +91,15 @@ const (
 	tType        = int(token.TYPE)
 	tVar         = int(token.VAR)
 	lastGoToken  = tVar
+
+	// additional tokens
+	tTilde = int(token.TILDE)
 )
 
 // additional enums
 const (
 	// token start
-	tNewline = (lastGoToken + 1) + iota
+	tNewline = (tTilde + 1) + iota
 	tWhitespace
 	tLineComment
 	tLineCommentEOF
@@ -108,32 +111,33 @@ const (
 	// token end
 
 	// error start
-	// 95
+	// 98
 	eErrorIllegal
 	eErrorEOF
+
+	// 100
 	eBOM
 	eBOMInComment
 	eBOMInRune
-
-	// 100
 	eBOMInStr
 	eNUL
 	eNULInStr
 	eEscape
 	eBigU
 	eEscapeUnknown
-	eHexLit
+	eNumber
+
+	// 110
 	eIncompleteComment
 	eIncompleteRune
 	eIncompleteEscape
-
-	// 110
 	eIncompleteStr
 	eIncompleteRawStr
-	eOctalLit
+	eInvalidDigit
 	eRune
 	eUTF8
 	eUTF8Rune
 	eUTF8Str
+	eDigitSep
 	// error end
 )
@@ -0,0 +1,138 @@
+package scanner
+
+import (
+	"fmt"
+	"go/token"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// FloatPrec is the precision in bits of the values decoded from FLOAT and
+// IMAG literals.
+const FloatPrec = 512
+
+// Decode returns the value of a literal scanned at pos: a *big.Int for INT, a
+// *big.Float for FLOAT, a *big.Float of the imaginary part for IMAG, a rune
+// for CHAR and a string for STRING. The literal is validated by scanning it
+// again, so an invalid literal is reported with the same message as the
+// Scanner, at the position of the error within the literal.
+func Decode(pos token.Position, tok token.Token, lit string) (interface{}, error) {
+	switch tok {
+	case token.INT:
+		return DecodeInt(pos, lit)
+	case token.FLOAT:
+		return DecodeFloat(pos, lit)
+	case token.IMAG:
+		return DecodeImag(pos, lit)
+	case token.CHAR:
+		return DecodeChar(pos, lit)
+	case token.STRING:
+		return DecodeString(pos, lit)
+	}
+	return nil, &Error{pos, fmt.Sprintf("%s is not a literal", tok)}
+}
+
+// DecodeInt returns the value of an INT literal.
+func DecodeInt(pos token.Position, lit string) (*big.Int, error) {
+	if err := validate(pos, token.INT, lit); err != nil {
+		return nil, err
+	}
+	v, ok := new(big.Int).SetString(lit, 0)
+	if !ok {
+		return nil, &Error{pos, "invalid integer literal " + lit}
+	}
+	return v, nil
+}
+
+// DecodeFloat returns the value of a FLOAT literal.
+func DecodeFloat(pos token.Position, lit string) (*big.Float, error) {
+	if err := validate(pos, token.FLOAT, lit); err != nil {
+		return nil, err
+	}
+	return parseFloat(pos, lit)
+}
+
+// DecodeImag returns the imaginary part of an IMAG literal.
+func DecodeImag(pos token.Position, lit string) (*big.Float, error) {
+	if err := validate(pos, token.IMAG, lit); err != nil {
+		return nil, err
+	}
+	// a decimal mantissa with leading zeros is not octal for backward
+	// compatibility, which is also how big.ParseFloat treats it.
+	return parseFloat(pos, lit[:len(lit)-1])
+}
+
+func parseFloat(pos token.Position, lit string) (*big.Float, error) {
+	v, _, err := big.ParseFloat(lit, 0, FloatPrec, big.ToNearestEven)
+	if err != nil {
+		return nil, &Error{pos, fmt.Sprintf("invalid floating-point literal %s: %v", lit, err)}
+	}
+	return v, nil
+}
+
+// DecodeChar returns the value of a CHAR literal.
+func DecodeChar(pos token.Position, lit string) (rune, error) {
+	if err := validate(pos, token.CHAR, lit); err != nil {
+		return 0, err
+	}
+	r, _, _, err := strconv.UnquoteChar(lit[1:len(lit)-1], '\'')
+	if err != nil {
+		return 0, &Error{pos, "illegal rune literal"}
+	}
+	return r, nil
+}
+
+// DecodeString returns the value of an interpreted or raw STRING literal.
+// Carriage returns are discarded from a raw string.
+func DecodeString(pos token.Position, lit string) (string, error) {
+	if err := validate(pos, token.STRING, lit); err != nil {
+		return "", err
+	}
+	if lit[0] == '`' {
+		return strings.ReplaceAll(lit[1:len(lit)-1], "\r", ""), nil
+	}
+	s, err := strconv.Unquote(lit)
+	if err != nil {
+		return "", &Error{pos, "invalid string literal"}
+	}
+	return s, nil
+}
+
+// validate scans lit and returns the first error reported by the scanner, or
+// an error if lit is not exactly a literal of tok.
+func validate(pos token.Position, tok token.Token, lit string) error {
+	var (
+		src  = []byte(lit)
+		file = token.NewFileSet().AddFile("", -1, len(src))
+		err  error
+		s    Scanner
+	)
+	s.Init(file, src, func(p token.Position, msg string) {
+		if err == nil {
+			err = &Error{advance(pos, lit[:p.Offset]), msg}
+		}
+	}, dontInsertSemis)
+	_, t, l := s.Scan()
+	if err != nil {
+		return err
+	}
+	if _, next, _ := s.Scan(); t != tok || next != token.EOF || (tok != token.STRING && l != lit) {
+		return &Error{pos, fmt.Sprintf("%q is not a valid %s literal", lit, tok)}
+	}
+	return nil
+}
+
+// advance returns the position after the text s starting at pos.
+func advance(pos token.Position, s string) token.Position {
+	pos.Offset += len(s)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		pos.Line += strings.Count(s, "\n")
+		pos.Column = 1
+		s = s[i+1:]
+	}
+	if pos.Column > 0 {
+		pos.Column += len(s)
+	}
+	return pos
+}
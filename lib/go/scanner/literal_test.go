@@ -0,0 +1,69 @@
+package scanner
+
+import (
+	"fmt"
+	"go/token"
+	"math/big"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	pos := token.Position{Filename: "x.go", Offset: 10, Line: 2, Column: 5}
+	for _, c := range []struct {
+		tok  token.Token
+		lit  string
+		want string
+	}{
+		{token.INT, "0", "0"},
+		{token.INT, "0x_1F", "31"},
+		{token.INT, "0b101", "5"},
+		{token.INT, "0o17", "15"},
+		{token.INT, "017", "15"},
+		{token.INT, "1_000", "1000"},
+		{token.INT, "123456789012345678901234567890", "123456789012345678901234567890"},
+		{token.FLOAT, "1.5", "1.5"},
+		{token.FLOAT, ".5e1", "5"},
+		{token.FLOAT, "0x1p-2", "0.25"},
+		{token.FLOAT, "1_0.2_5", "10.25"},
+		{token.FLOAT, "1e400", "1e+400"},
+		{token.IMAG, "0123i", "123"},
+		{token.IMAG, "1.5i", "1.5"},
+		{token.IMAG, "0x1p2i", "4"},
+		{token.CHAR, "'a'", "97"},
+		{token.CHAR, `'\n'`, "10"},
+		{token.CHAR, `'\xff'`, "255"},
+		{token.CHAR, `'\u4e16'`, "19990"},
+		{token.CHAR, "'世'", "19990"},
+		{token.STRING, `"a\tb"`, "\"a\\tb\""},
+		{token.STRING, `"\xff世"`, "\"\\xff世\""},
+		{token.STRING, "`a\r\nb`", "\"a\\nb\""},
+
+		{token.INT, "08", "x.go:2:6: invalid digit '8' in octal literal"},
+		{token.INT, "1__0", "x.go:2:7: '_' must separate successive digits"},
+		{token.INT, "1.5", `x.go:2:5: "1.5" is not a valid INT literal`},
+		{token.CHAR, `'\q'`, "x.go:2:7: unknown escape sequence"},
+		{token.CHAR, `'ab'`, "x.go:2:5: illegal rune literal"},
+		{token.STRING, `"abc`, "x.go:2:5: string literal not terminated"},
+		{token.STRING, "`a\nb\ufeff`", "x.go:3:2: illegal byte order mark"},
+		{token.IDENT, "a", "x.go:2:5: IDENT is not a literal"},
+	} {
+		v, err := Decode(pos, c.tok, c.lit)
+		var got string
+		switch v := v.(type) {
+		case *big.Int:
+			got = v.String()
+		case *big.Float:
+			got = v.Text('g', 10)
+		case rune:
+			got = fmt.Sprint(v)
+		case string:
+			got = fmt.Sprintf("%q", v)
+		}
+		if err != nil {
+			got = err.Error()
+		}
+		if got != c.want {
+			t.Errorf("%s %s: got %s, want %s", c.tok, c.lit, got, c.want)
+		}
+	}
+}
@@ -27,10 +27,9 @@ type Scanner struct {
 	preSemi    bool
 	semiPos    int
 
-	file      *token.File // source file handle
-	fileBase  int         // cache of file.Base()
-	dir       string      // cache of the directory portion of file.Name()
-	lineStart int         // record the start position of each line
+	file     *token.File // source file handle
+	fileBase int         // cache of file.Base()
+	dir      string      // cache of the directory portion of file.Name()
 
 	errScanner scan.Scanner
 	ErrorCount int          // number of errors encountered
@@ -48,6 +47,7 @@ func (s *Scanner) Init(file *token.File, src []byte, err ErrorHandler, mode Mode
 	s.errScanner = scan.Scanner{Matcher: getErrorMatcher()}
 	s.src = skipBOM(src)
 	s.tokScanner.SetSource(s.src)
+	s.tokScanner.SetLines(scan.NewLines(file))
 	s.errScanner.SetSource(s.src)
 
 	s.file = file
@@ -86,8 +86,6 @@ func (s *Scanner) Scan() (token.Pos, token.Token, string) {
 					break
 				}
 			}
-			s.file.AddLine(t.Hi)
-			s.lineStart = t.Hi
 			continue
 		case tIdentifier, tInt, tFloat, tImag, tRune, tString, tReturn, tBreak, tContinue, tFallthrough:
 			s.preSemi, s.semiPos = true, t.Hi+1
@@ -104,12 +102,10 @@ func (s *Scanner) Scan() (token.Pos, token.Token, string) {
 				}
 			}
 			val = s.src[t.Lo:t.Hi]
-			if t.ID == tLineCommentInfo && t.Lo == s.lineStart {
+			if t.ID == tLineCommentInfo && s.tokScanner.Position(t.Lo).Column == 1 {
 				s.interpretLineComment(val, t.Hi)
 			}
 			if val[len(val)-1] == '\n' {
-				s.file.AddLine(t.Hi)
-				s.lineStart = t.Hi
 				val = val[:len(val)-1]
 			}
 			if s.mode&ScanComments == 0 {
@@ -130,17 +126,11 @@ func (s *Scanner) Scan() (token.Pos, token.Token, string) {
 					break
 				}
 			}
-			val = s.src[t.Lo:t.Hi]
-			for i, c := range val {
-				if c == '\n' {
-					s.file.AddLine(t.Lo + i + 1)
-				}
-			}
 			if s.mode&ScanComments == 0 {
 				continue
 			}
 			t.ID = tComment
-			val = stripCR(val)
+			val = stripCR(s.src[t.Lo:t.Hi])
 		case tGeneralCommentSL:
 			if s.preSemi {
 				s.preSemi = false
@@ -178,13 +168,7 @@ func (s *Scanner) Scan() (token.Pos, token.Token, string) {
 		case tRawStringLit:
 			s.preSemi, s.semiPos = true, t.Hi+1
 			t.ID = tString
-			val = s.src[t.Lo:t.Hi]
-			for i, c := range val {
-				if c == '\n' {
-					s.file.AddLine(t.Lo + i + 1)
-				}
-			}
-			val = stripCR(val)
+			val = stripCR(s.src[t.Lo:t.Hi])
 		case tEOF:
 			if s.preSemi {
 				s.preSemi = false
@@ -208,19 +192,26 @@ func (s *Scanner) Scan() (token.Pos, token.Token, string) {
 			t.ID = tComment
 			val = s.src[t.Lo:t.Hi]
 			s.error(t.Lo, "comment not terminated")
-		case eOctalLit:
+		case eInvalidDigit:
+			s.preSemi, s.semiPos = true, t.Hi+1
 			t.ID = tInt
 			val = s.src[t.Lo:t.Hi]
-			s.error(t.Lo, "illegal octal number")
-		case eHexLit:
-			t.ID = tInt
+			s.invalidDigit(t.Lo, val)
+			s.invalidSep(t.Lo, val)
+		case eDigitSep:
+			s.preSemi, s.semiPos = true, t.Hi+1
+			val = s.src[t.Lo:t.Hi]
+			t.ID = numberToken(val)
+			s.invalidSep(t.Lo, val)
+		case eNumber:
+			s.preSemi, s.semiPos = true, t.Hi+1
 			val = s.src[t.Lo:t.Hi]
-			s.error(t.Lo, "illegal hexadecimal number")
+			t.ID = s.numberError(t.Lo, val)
 		case eIllegal:
 			t, val = s.handleError(t.Lo, t.Hi)
 		default:
 			s.preSemi = false
-			if t.ID < firstOp || t.ID > lastOp {
+			if (t.ID < firstOp || t.ID > lastOp) && t.ID != tTilde {
 				val = s.src[t.Lo:t.Hi]
 			}
 		}
@@ -347,3 +338,195 @@ func decodeRune(bs []byte) rune {
 	}
 	return r
 }
+
+// numberToken returns the token of a number literal.
+func numberToken(lit []byte) int {
+	exponent := "eEpP"
+	if len(lit) > 1 && lower(lit[1]) == 'x' {
+		exponent = "pP"
+	}
+	switch {
+	case lit[len(lit)-1] == 'i':
+		return tImag
+	case bytes.ContainsAny(lit, "."+exponent):
+		return tFloat
+	}
+	return tInt
+}
+
+// invalidDigit reports the first digit that is invalid for the base of a
+// binary or octal integer literal.
+func (s *Scanner) invalidDigit(pos int, lit []byte) {
+	prefix, max := lower(lit[1]), byte('8')
+	if prefix == 'b' {
+		max = '2'
+	}
+	for i := 1; i < len(lit); i++ {
+		if ch := lit[i]; isDecimal(ch) && ch >= max {
+			s.error(pos+i, fmt.Sprintf("invalid digit %q in %s", ch, litname(prefix)))
+			return
+		}
+	}
+}
+
+// invalidSep reports the first invalid separator of a number literal.
+func (s *Scanner) invalidSep(pos int, lit []byte) {
+	x1 := byte(' ') // prefix char, we only care if it's 'x'
+	d := byte('.')  // digit, one of '_', '0' (a digit), or '.' (anything else)
+	i := 0
+
+	// a prefix counts as a digit
+	if len(lit) >= 2 && lit[0] == '0' {
+		x1 = lower(lit[1])
+		if x1 == 'x' || x1 == 'o' || x1 == 'b' {
+			d = '0'
+			i = 2
+		}
+	}
+
+	// mantissa and exponent
+	for ; i < len(lit); i++ {
+		p := d // previous digit
+		d = lit[i]
+		switch {
+		case d == '_':
+			if p != '0' {
+				s.error(pos+i, "'_' must separate successive digits")
+				return
+			}
+		case isDecimal(d) || x1 == 'x' && isHex(d):
+			d = '0'
+		default:
+			if p == '_' {
+				s.error(pos+i-1, "'_' must separate successive digits")
+				return
+			}
+			d = '.'
+		}
+	}
+	if d == '_' {
+		s.error(pos+len(lit)-1, "'_' must separate successive digits")
+	}
+}
+
+// numberError reports the errors of any other malformed number literal the
+// same way as go/scanner does, and returns its token.
+func (s *Scanner) numberError(pos int, lit []byte) int {
+	i := 0
+	peek := func() byte {
+		if i < len(lit) {
+			return lit[i]
+		}
+		return 0
+	}
+	invalid := -1 // index of invalid digit in literal, or < 0
+	digits := func(base int) (digsep int) {
+		for ; i < len(lit); i++ {
+			ch := lit[i]
+			if ch == '_' {
+				digsep |= 2
+				continue
+			}
+			if !isDecimal(ch) && !(base == 16 && isHex(ch)) {
+				break
+			}
+			if base < 10 && ch >= byte('0'+base) && invalid < 0 {
+				invalid = i
+			}
+			digsep |= 1
+		}
+		return
+	}
+
+	tok := tInt
+	base := 10        // number base
+	prefix := byte(0) // one of 0 (decimal), '0' (0-octal), 'x', 'o', or 'b'
+	digsep := 0       // bit 0: digit present, bit 1: '_' present
+
+	// integer part
+	if peek() != '.' {
+		if peek() == '0' {
+			i++
+			switch lower(peek()) {
+			case 'x':
+				i++
+				base, prefix = 16, 'x'
+			case 'o':
+				i++
+				base, prefix = 8, 'o'
+			case 'b':
+				i++
+				base, prefix = 2, 'b'
+			default:
+				base, prefix = 8, '0'
+				digsep = 1 // leading 0
+			}
+		}
+		digsep |= digits(base)
+	}
+
+	// fractional part
+	if peek() == '.' {
+		tok = tFloat
+		if prefix == 'o' || prefix == 'b' {
+			s.error(pos+i, "invalid radix point in "+litname(prefix))
+		}
+		i++
+		digsep |= digits(base)
+	}
+
+	if digsep&1 == 0 {
+		s.error(pos+i, litname(prefix)+" has no digits")
+	}
+
+	// exponent
+	if e := lower(peek()); e == 'e' || e == 'p' {
+		switch {
+		case e == 'e' && prefix != 0 && prefix != '0':
+			s.error(pos+i, fmt.Sprintf("%q exponent requires decimal mantissa", lit[i]))
+		case e == 'p' && prefix != 'x':
+			s.error(pos+i, fmt.Sprintf("%q exponent requires hexadecimal mantissa", lit[i]))
+		}
+		i++
+		tok = tFloat
+		if peek() == '+' || peek() == '-' {
+			i++
+		}
+		ds := digits(10)
+		digsep |= ds
+		if ds&1 == 0 {
+			s.error(pos+i, "exponent has no digits")
+		}
+	} else if prefix == 'x' && tok == tFloat {
+		s.error(pos+i, "hexadecimal mantissa requires a 'p' exponent")
+	}
+
+	// suffix 'i'
+	if peek() == 'i' {
+		tok = tImag
+	}
+
+	if tok == tInt && invalid >= 0 {
+		s.error(pos+invalid, fmt.Sprintf("invalid digit %q in %s", lit[invalid], litname(prefix)))
+	}
+	if digsep&2 != 0 {
+		s.invalidSep(pos, lit)
+	}
+	return tok
+}
+
+func litname(prefix byte) string {
+	switch prefix {
+	case 'x':
+		return "hexadecimal literal"
+	case 'o', '0':
+		return "octal literal"
+	case 'b':
+		return "binary literal"
+	}
+	return "decimal literal"
+}
+
+func lower(ch byte) byte     { return ('a' - 'A') | ch }
+func isDecimal(ch byte) bool { return '0' <= ch && ch <= '9' }
+func isHex(ch byte) bool     { return '0' <= ch && ch <= '9' || 'a' <= lower(ch) && lower(ch) <= 'f' }
@@ -5,12 +5,19 @@
 package scanner
 
 import (
+	"bytes"
+	"fmt"
 	"go/token"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+
+	"h12.io/gombi/scan"
 )
 
 var fset = token.NewFileSet()
@@ -147,6 +154,7 @@ var tokens = [...]elt{
 	{token.RBRACE, "}", operator},
 	{token.SEMICOLON, ";", operator},
 	{token.COLON, ":", operator},
+	{token.TILDE, "~", operator},
 
 	// Keywords
 	{token.BREAK, "break", keyword},
@@ -716,10 +724,11 @@ var errors = []struct {
 	{"078.", token.FLOAT, 0, "078.", ""},
 	{"07801234567.", token.FLOAT, 0, "07801234567.", ""},
 	{"078e0", token.FLOAT, 0, "078e0", ""},
-	{"078", token.INT, 0, "078", "illegal octal number"},
-	{"07800000009", token.INT, 0, "07800000009", "illegal octal number"},
-	{"0x", token.INT, 0, "0x", "illegal hexadecimal number"},
-	{"0X", token.INT, 0, "0X", "illegal hexadecimal number"},
+	{"0E", token.FLOAT, 2, "0E", "exponent has no digits"}, // issue 17621
+	{"078", token.INT, 2, "078", "invalid digit '8' in octal literal"},
+	{"07090000008", token.INT, 3, "07090000008", "invalid digit '9' in octal literal"},
+	{"0x", token.INT, 2, "0x", "hexadecimal literal has no digits"},
+	{"0X", token.INT, 2, "0X", "hexadecimal literal has no digits"},
 	{"\"abc\x00def\"", token.STRING, 4, "\"abc\x00def\"", "illegal character NUL"},
 	{"\"abc\x80def\"", token.STRING, 4, "\"abc\x80def\"", "illegal UTF-8 encoding"},
 	{"\ufeff\ufeff", token.ILLEGAL, 3, "\ufeff\ufeff", "illegal byte order mark"},                        // only first BOM is ignored
@@ -734,6 +743,206 @@ func TestScanErrors(t *testing.T) {
 	}
 }
 
+func TestNumbers(t *testing.T) {
+	for _, test := range []struct {
+		tok              token.Token
+		src, tokens, err string
+	}{
+		// binaries
+		{token.INT, "0b0", "0b0", ""},
+		{token.INT, "0b1010", "0b1010", ""},
+		{token.INT, "0B1110", "0B1110", ""},
+
+		{token.INT, "0b", "0b", "binary literal has no digits"},
+		{token.INT, "0b0190", "0b0190", "invalid digit '9' in binary literal"},
+		{token.INT, "0b01a0", "0b01 a0", ""}, // only accept 0-9
+
+		{token.FLOAT, "0b.", "0b.", "invalid radix point in binary literal"},
+		{token.FLOAT, "0b.1", "0b.1", "invalid radix point in binary literal"},
+		{token.FLOAT, "0b1.0", "0b1.0", "invalid radix point in binary literal"},
+		{token.FLOAT, "0b1e10", "0b1e10", "'e' exponent requires decimal mantissa"},
+		{token.FLOAT, "0b1P-1", "0b1P-1", "'P' exponent requires hexadecimal mantissa"},
+
+		{token.IMAG, "0b10i", "0b10i", ""},
+		{token.IMAG, "0b10.0i", "0b10.0i", "invalid radix point in binary literal"},
+
+		// octals
+		{token.INT, "0o0", "0o0", ""},
+		{token.INT, "0o1234", "0o1234", ""},
+		{token.INT, "0O1234", "0O1234", ""},
+
+		{token.INT, "0o", "0o", "octal literal has no digits"},
+		{token.INT, "0o8123", "0o8123", "invalid digit '8' in octal literal"},
+		{token.INT, "0o1293", "0o1293", "invalid digit '9' in octal literal"},
+		{token.INT, "0o12a3", "0o12 a3", ""}, // only accept 0-9
+
+		{token.FLOAT, "0o.", "0o.", "invalid radix point in octal literal"},
+		{token.FLOAT, "0o.2", "0o.2", "invalid radix point in octal literal"},
+		{token.FLOAT, "0o1.2", "0o1.2", "invalid radix point in octal literal"},
+		{token.FLOAT, "0o1E+2", "0o1E+2", "'E' exponent requires decimal mantissa"},
+		{token.FLOAT, "0o1p10", "0o1p10", "'p' exponent requires hexadecimal mantissa"},
+
+		{token.IMAG, "0o10i", "0o10i", ""},
+		{token.IMAG, "0o10e0i", "0o10e0i", "'e' exponent requires decimal mantissa"},
+
+		// 0-octals
+		{token.INT, "0", "0", ""},
+		{token.INT, "0123", "0123", ""},
+
+		{token.INT, "08123", "08123", "invalid digit '8' in octal literal"},
+		{token.INT, "01293", "01293", "invalid digit '9' in octal literal"},
+		{token.INT, "0F.", "0 F .", ""}, // only accept 0-9
+		{token.INT, "0123F.", "0123 F .", ""},
+		{token.INT, "0123456x", "0123456 x", ""},
+
+		// decimals
+		{token.INT, "1", "1", ""},
+		{token.INT, "1234", "1234", ""},
+
+		{token.INT, "1f", "1 f", ""}, // only accept 0-9
+
+		{token.IMAG, "0i", "0i", ""},
+		{token.IMAG, "0678i", "0678i", ""},
+
+		// decimal floats
+		{token.FLOAT, "0.", "0.", ""},
+		{token.FLOAT, "123.", "123.", ""},
+		{token.FLOAT, "0123.", "0123.", ""},
+
+		{token.FLOAT, ".0", ".0", ""},
+		{token.FLOAT, ".123", ".123", ""},
+		{token.FLOAT, ".0123", ".0123", ""},
+
+		{token.FLOAT, "0.0", "0.0", ""},
+		{token.FLOAT, "123.123", "123.123", ""},
+		{token.FLOAT, "0123.0123", "0123.0123", ""},
+
+		{token.FLOAT, "0e0", "0e0", ""},
+		{token.FLOAT, "123e+0", "123e+0", ""},
+		{token.FLOAT, "0123E-1", "0123E-1", ""},
+
+		{token.FLOAT, "0.e+1", "0.e+1", ""},
+		{token.FLOAT, "123.E-10", "123.E-10", ""},
+		{token.FLOAT, "0123.e123", "0123.e123", ""},
+
+		{token.FLOAT, ".0e-1", ".0e-1", ""},
+		{token.FLOAT, ".123E+10", ".123E+10", ""},
+		{token.FLOAT, ".0123E123", ".0123E123", ""},
+
+		{token.FLOAT, "0.0e1", "0.0e1", ""},
+		{token.FLOAT, "123.123E-10", "123.123E-10", ""},
+		{token.FLOAT, "0123.0123e+456", "0123.0123e+456", ""},
+
+		{token.FLOAT, "0e", "0e", "exponent has no digits"},
+		{token.FLOAT, "0E+", "0E+", "exponent has no digits"},
+		{token.FLOAT, "1e+f", "1e+ f", "exponent has no digits"},
+		{token.FLOAT, "0p0", "0p0", "'p' exponent requires hexadecimal mantissa"},
+		{token.FLOAT, "1.0P-1", "1.0P-1", "'P' exponent requires hexadecimal mantissa"},
+
+		{token.IMAG, "0.i", "0.i", ""},
+		{token.IMAG, ".123i", ".123i", ""},
+		{token.IMAG, "123.123i", "123.123i", ""},
+		{token.IMAG, "123e+0i", "123e+0i", ""},
+		{token.IMAG, "123.E-10i", "123.E-10i", ""},
+		{token.IMAG, ".123E+10i", ".123E+10i", ""},
+
+		// hexadecimals
+		{token.INT, "0x0", "0x0", ""},
+		{token.INT, "0x1234", "0x1234", ""},
+		{token.INT, "0xcafef00d", "0xcafef00d", ""},
+		{token.INT, "0XCAFEF00D", "0XCAFEF00D", ""},
+
+		{token.INT, "0x", "0x", "hexadecimal literal has no digits"},
+		{token.INT, "0x1g", "0x1 g", ""},
+
+		{token.IMAG, "0xf00i", "0xf00i", ""},
+
+		// hexadecimal floats
+		{token.FLOAT, "0x0p0", "0x0p0", ""},
+		{token.FLOAT, "0x12efp-123", "0x12efp-123", ""},
+		{token.FLOAT, "0xABCD.p+0", "0xABCD.p+0", ""},
+		{token.FLOAT, "0x.0189P-0", "0x.0189P-0", ""},
+		{token.FLOAT, "0x1.ffffp+1023", "0x1.ffffp+1023", ""},
+
+		{token.FLOAT, "0x.", "0x.", "hexadecimal literal has no digits"},
+		{token.FLOAT, "0x0.", "0x0.", "hexadecimal mantissa requires a 'p' exponent"},
+		{token.FLOAT, "0x.0", "0x.0", "hexadecimal mantissa requires a 'p' exponent"},
+		{token.FLOAT, "0x1.1", "0x1.1", "hexadecimal mantissa requires a 'p' exponent"},
+		{token.FLOAT, "0x1.1e0", "0x1.1e0", "hexadecimal mantissa requires a 'p' exponent"},
+		{token.FLOAT, "0x1.2gp1a", "0x1.2 gp1a", "hexadecimal mantissa requires a 'p' exponent"},
+		{token.FLOAT, "0x0p", "0x0p", "exponent has no digits"},
+		{token.FLOAT, "0xeP-", "0xeP-", "exponent has no digits"},
+		{token.FLOAT, "0x1234PAB", "0x1234P AB", "exponent has no digits"},
+		{token.FLOAT, "0x1.2p1a", "0x1.2p1 a", ""},
+
+		{token.IMAG, "0xf00.bap+12i", "0xf00.bap+12i", ""},
+
+		// separators
+		{token.INT, "0b_1000_0001", "0b_1000_0001", ""},
+		{token.INT, "0o_600", "0o_600", ""},
+		{token.INT, "0_466", "0_466", ""},
+		{token.INT, "1_000", "1_000", ""},
+		{token.FLOAT, "1_000.000_1", "1_000.000_1", ""},
+		{token.IMAG, "10e+1_2_3i", "10e+1_2_3i", ""},
+		{token.INT, "0x_f00d", "0x_f00d", ""},
+		{token.FLOAT, "0x_f00d.0p1_2", "0x_f00d.0p1_2", ""},
+
+		{token.INT, "0b__1000", "0b__1000", "'_' must separate successive digits"},
+		{token.INT, "0o60___0", "0o60___0", "'_' must separate successive digits"},
+		{token.INT, "0466_", "0466_", "'_' must separate successive digits"},
+		{token.FLOAT, "1_.", "1_.", "'_' must separate successive digits"},
+		{token.FLOAT, "0._1", "0._1", "'_' must separate successive digits"},
+		{token.FLOAT, "2.7_e0", "2.7_e0", "'_' must separate successive digits"},
+		{token.IMAG, "10e+12_i", "10e+12_i", "'_' must separate successive digits"},
+		{token.INT, "0x___0", "0x___0", "'_' must separate successive digits"},
+		{token.FLOAT, "0x1.0_p0", "0x1.0_p0", "'_' must separate successive digits"},
+	} {
+		var s Scanner
+		var err string
+		s.Init(fset.AddFile("", fset.Base(), len(test.src)), []byte(test.src), func(_ token.Position, msg string) {
+			if err == "" {
+				err = msg
+			}
+		}, 0)
+		for i, want := range strings.Split(test.tokens, " ") {
+			err = ""
+			_, tok, lit := s.Scan()
+
+			// compute lit where for tokens where lit is not defined
+			switch tok {
+			case token.PERIOD:
+				lit = "."
+			case token.ADD:
+				lit = "+"
+			case token.SUB:
+				lit = "-"
+			}
+
+			if i == 0 {
+				if tok != test.tok {
+					t.Errorf("%q: got token %s; want %s", test.src, tok, test.tok)
+				}
+				if err != test.err {
+					t.Errorf("%q: got error %q; want %q", test.src, err, test.err)
+				}
+			}
+
+			if lit != want {
+				t.Errorf("%q: got literal %q (%s); want %s", test.src, lit, tok, want)
+			}
+		}
+
+		// make sure we read all
+		_, tok, _ := s.Scan()
+		if tok == token.SEMICOLON {
+			_, tok, _ = s.Scan()
+		}
+		if tok != token.EOF {
+			t.Errorf("%q: got %s; want EOF", test.src, tok)
+		}
+	}
+}
+
 func BenchmarkScan(b *testing.B) {
 	b.StopTimer()
 	fset := token.NewFileSet()
@@ -773,3 +982,252 @@ func BenchmarkScanFile(b *testing.B) {
 		}
 	}
 }
+
+func TestCache(t *testing.T) {
+	tokMatcher, errMatcher := spec()
+	if !tokMatcherCache.Equal(tokMatcher) || !errMatcherCache.Equal(errMatcher) {
+		t.Fatal("cache.go is out of date with the spec, run go generate")
+	}
+}
+
+// TestGotoMatcher compares the tokens scanned by the goto code of the token
+// matcher with the ones scanned by the tables.
+func TestGotoMatcher(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiling the goto code takes a while")
+	}
+	exe := buildGotoCommand(t)
+	files := []string{"scanner.go", runtime.GOROOT() + "/src/go/scanner/scanner.go"}
+	var err error
+	for i, filename := range files {
+		if files[i], err = filepath.Abs(filename); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out, err := exec.Command(exe, files...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+	got := strings.Split(string(out), "\n")
+	var want []string
+	for _, filename := range files {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := scan.Scanner{Matcher: getTokenMatcher()}
+		for s.SetSource(src); s.Scan(); {
+			want = append(want, fmt.Sprint(*s.Token(), s.Error()))
+			if s.Token().ID == tEOF {
+				break
+			}
+		}
+	}
+	want = append(want, "")
+	if len(got) != len(want) {
+		t.Fatalf("got %d tokens, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("token %d: got %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+// buildGotoCommand builds the goto code of the token matcher in a temporary
+// module, because the code is too large to be kept in the repository, and
+// returns the path of the command, see gotoMain.
+func buildGotoCommand(tb testing.TB) string {
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		tb.Skip("go command not found")
+	}
+	root, err := filepath.Abs("../../..")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	dir := tb.TempDir()
+	writeGotoModule(tb, dir, root)
+	exe := filepath.Join(dir, "gotomatcher")
+	cmd := exec.Command(goCmd, "build", "-o", exe, ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		tb.Fatalf("%v\n%s", err, out)
+	}
+	return exe
+}
+
+// writeGotoModule writes a module in dir with the goto code of the token
+// matcher and the command of gotoMain.
+func writeGotoModule(tb testing.TB, dir, root string) {
+	mod, err := ioutil.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	mod = bytes.Replace(mod, []byte("module h12.io/gombi"), []byte("module gotomatcher"), 1)
+	mod = append(mod, fmt.Sprintf("\nrequire h12.io/gombi v0.0.0\n\nreplace h12.io/gombi => %s\n", root)...)
+	sum, err := ioutil.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	var code bytes.Buffer
+	if err := scan.WriteCache(&code, "main", []scan.CacheVar{{Name: "tokGotoMatcher", Matcher: getTokenMatcher(), Goto: true}}); err != nil {
+		tb.Fatal(err)
+	}
+	for name, data := range map[string][]byte{
+		"go.mod":  mod,
+		"go.sum":  sum,
+		"goto.go": code.Bytes(),
+		"main.go": []byte(gotoMain),
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			tb.Fatal(err)
+		}
+	}
+}
+
+// gotoMain is a command printing the tokens of the files in its arguments
+// scanned by the goto code, or with "-bench n file", printing the nanoseconds
+// taken to scan the file n times.
+const gotoMain = `package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"h12.io/gombi/scan"
+)
+
+func main() {
+	s := scan.GotoScanner{GotoMatcher: tokGotoMatcher}
+	if len(os.Args) == 4 && os.Args[1] == "-bench" {
+		n, err := strconv.Atoi(os.Args[2])
+		if err != nil {
+			exit(err)
+		}
+		src := readFile(os.Args[3])
+		start := time.Now()
+		for i := 0; i < n; i++ {
+			for s.SetSource(src); s.Scan() && s.Token().ID != s.EOF; {
+			}
+		}
+		fmt.Println(time.Since(start).Nanoseconds())
+		return
+	}
+	for _, filename := range os.Args[1:] {
+		for s.SetSource(readFile(filename)); s.Scan(); {
+			fmt.Println(*s.Token(), s.Error())
+			if s.Token().ID == s.EOF {
+				break
+			}
+		}
+	}
+}
+
+func readFile(filename string) []byte {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		exit(err)
+	}
+	return src
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+`
+
+func benchmarkMatch(b *testing.B, scanAll func(src []byte)) {
+	b.StopTimer()
+	src, err := ioutil.ReadFile(runtime.GOROOT() + "/src/go/scanner/scanner.go")
+	if err != nil {
+		panic(err)
+	}
+	b.SetBytes(int64(len(src)))
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		scanAll(src)
+	}
+}
+
+func BenchmarkMatchTable(b *testing.B) {
+	s := scan.Scanner{Matcher: getTokenMatcher()}
+	benchmarkMatch(b, func(src []byte) {
+		for s.SetSource(src); s.Scan() && s.Token().ID != tEOF; {
+		}
+	})
+}
+
+// BenchmarkMatchGoto scans the same file as BenchmarkMatchTable with the goto
+// code. The scans are timed by the command of gotoMain itself, so that neither
+// building nor starting the command is counted.
+func BenchmarkMatchGoto(b *testing.B) {
+	b.StopTimer()
+	exe := buildGotoCommand(b)
+	filename := runtime.GOROOT() + "/src/go/scanner/scanner.go"
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		panic(err)
+	}
+	b.StartTimer()
+	out, err := exec.Command(exe, "-bench", strconv.Itoa(b.N), filename).Output()
+	if err != nil {
+		b.Fatal(err)
+	}
+	ns, err := strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportMetric(ns/float64(b.N), "ns/op")
+	b.ReportMetric(float64(len(src))*float64(b.N)/ns*1e3, "MB/s")
+}
+
+func benchmarkScanAll(b *testing.B, workers int) {
+	b.StopTimer()
+	src, err := ioutil.ReadFile(runtime.GOROOT() + "/src/go/scanner/scanner.go")
+	if err != nil {
+		panic(err)
+	}
+	src = bytes.Repeat(src, 16)
+	s := scan.Scanner{Matcher: getTokenMatcher()}
+	b.SetBytes(int64(len(src)))
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		s.ScanAll(src, workers)
+	}
+}
+
+func BenchmarkScanAllSequential(b *testing.B) {
+	benchmarkScanAll(b, 1)
+}
+
+func BenchmarkScanAllParallel(b *testing.B) {
+	benchmarkScanAll(b, runtime.GOMAXPROCS(0))
+}
+
+func TestLinePositions(t *testing.T) {
+	src := []byte("a\nb /*\n*/ c `\n\n` // x\nd")
+	file := fset.AddFile("lines.go", fset.Base(), len(src))
+	var s Scanner
+	s.Init(file, src, nil, 0)
+	var got []string
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.IDENT {
+			p := fset.Position(pos)
+			got = append(got, fmt.Sprintf("%s:%d:%d", lit, p.Line, p.Column))
+		}
+	}
+	want := []string{"a:1:1", "b:2:1", "c:3:4", "d:6:1"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -1,22 +1,22 @@
 package scanner
 
 import (
-	"fmt"
-	"os"
 	"unicode/utf8"
 
 	"h12.io/dfa"
 	"h12.io/gombi/scan"
 )
 
+//go:generate go run h12.io/gombi/cmd/gombi gen -spec spec -var tokMatcherCache,errMatcherCache
+
+// enableCache uses the precompiled matchers in cache.go instead of building
+// them from spec, which must be regenerated by go generate after the spec is
+// changed.
 const (
-	enableCache = false
+	enableCache = true
 )
 
 func spec() (tokMatcher, errMatcher *scan.Matcher) {
-	if enableCache {
-		return tokMatcherCache.Init(), errMatcherCache.Init()
-	}
 	var (
 		c     = scan.Char
 		b     = scan.Between
@@ -37,37 +37,58 @@ func spec() (tokMatcher, errMatcher *scan.Matcher) {
 		unicodeDigit  = class(`Nd`)
 		letter        = or(unicodeLetter, c(`_`))
 		decimalDigit  = b('0', '9')
+		binaryDigit   = b('0', '1')
 		octalDigit    = b('0', '7')
 		hexDigit      = or(b('0', '9'), b('A', 'F'), b('a', 'f'))
 
+		// digits returns digit { [ "_" ] digit }.
+		digits = func(digit *dfa.M) *dfa.M {
+			return con(digit, con(c(`_`).Optional(), digit).Repeat())
+		}
+		// optional is used instead of Optional for digits, whose start state is
+		// merged with the state after "_" and must not become final.
+		optional = func(m *dfa.M) *dfa.M {
+			return or(m, ``)
+		}
+		// sepDigits returns at least one digit with "_" anywhere.
+		sepDigits = func(digit *dfa.M) *dfa.M {
+			return con(or(digit, `_`).Repeat(), digit, or(digit, `_`).Repeat())
+		}
+
 		// http://www.cs.dartmouth.edu/~mckeeman/cs118/assignments/comment.html
 		commentText = func(char *dfa.M) *dfa.M {
 			return con(char.Exclude(`*`).Repeat(), `*`).Loop(ifNot('/'))
 			//stars := s(`*`).AtLeast(1)
 			//return con(or(char.Exclude(`*`), con(stars, char.Exclude(`/`))).Repeat(), stars)
 		}
-		keywords = or(`break`, `case`, `chan`, `const`, `continue`, `default`,
-			`defer`, `else`, `fallthrough`, `for`, `func`, `go`, `goto`, `if`,
-			`import`, `interface`, `map`, `package`, `range`, `return`, `select`,
-			`struct`, `switch`, `type`, `var`)
 		whitespaces          = c(" \t\r").AtLeast(1)
 		lineCommentInfo      = con(`//line `, unicodeChar.AtLeast(1), newline)
-		lineComment          = con(`//`, unicodeChar.Repeat(), newline).Exclude(lineCommentInfo)
+		lineComment          = con(`//`, unicodeChar.Repeat(), newline)
 		lineCommentEOF       = con(`//`, unicodeChar.Repeat())
 		generalCommentSL     = con(`/*`, commentText(valid.Exclude("\n")), `/`)
 		generalCommentML     = con(`/*`, commentText(valid.Exclude("\n")).Optional(), "\n", commentText(valid), `/`)
-		identifier           = con(letter, or(letter, unicodeDigit).Repeat()).Exclude(keywords)
-		hexLit               = con(`0`, c("xX"), hexDigit.AtLeast(1))
-		decimalLit           = con(b('1', '9'), decimalDigit.Repeat())
-		octalLit             = con(`0`, octalDigit.Repeat())
-		intLit               = or(hexLit, decimalLit, octalLit)
-		decimals             = decimalDigit.AtLeast(1)
-		exponent             = con(c("eE"), c("+-").Optional(), decimals)
-		floatLit1            = con(decimals, `.`, decimals.Optional(), exponent.Optional())
-		floatLit2            = con(decimals, exponent)
-		floatLit3            = con(`.`, decimals, exponent.Optional())
-		floatLit             = or(floatLit1, floatLit2, floatLit3)
-		imaginaryLit         = con(or(floatLit, decimals), `i`)
+		identifier           = con(letter, or(letter, unicodeDigit).Repeat())
+		decimalDigits        = digits(decimalDigit)
+		hexDigits            = digits(hexDigit)
+		decimalLit           = or(`0`, con(b('1', '9'), con(c(`_`).Optional(), decimalDigits).Optional()))
+		binaryLit            = con(`0`, c("bB"), c(`_`).Optional(), digits(binaryDigit))
+		octalLit             = con(`0`, c("oO").Optional(), c(`_`).Optional(), digits(octalDigit))
+		hexLit               = con(`0`, c("xX"), c(`_`).Optional(), hexDigits)
+		intLit               = or(decimalLit, binaryLit, octalLit, hexLit)
+		decimalExponent      = con(c("eE"), c("+-").Optional(), decimalDigits)
+		decimalFloatLit1     = con(decimalDigits, `.`, optional(decimalDigits), decimalExponent.Optional())
+		decimalFloatLit2     = con(decimalDigits, decimalExponent)
+		decimalFloatLit3     = con(`.`, decimalDigits, decimalExponent.Optional())
+		decimalFloatLit      = or(decimalFloatLit1, decimalFloatLit2, decimalFloatLit3)
+		hexMantissa1         = con(c(`_`).Optional(), hexDigits, `.`, optional(hexDigits))
+		hexMantissa2         = con(c(`_`).Optional(), hexDigits)
+		hexMantissa3         = con(`.`, hexDigits)
+		hexMantissa          = or(hexMantissa1, hexMantissa2, hexMantissa3)
+		hexExponent          = con(c("pP"), c("+-").Optional(), decimalDigits)
+		hexFloatLit          = con(`0`, c("xX"), hexMantissa, hexExponent)
+		floatLit             = or(decimalFloatLit, hexFloatLit)
+		imaginaryLit         = con(or(decimalDigits, intLit, floatLit), `i`)
+		numberLit            = or(intLit, floatLit, imaginaryLit)
 		hexByteValue         = con(`\x`, hexDigit.Repeat(2))
 		octalByteValue       = con(`\`, octalDigit.Repeat(3))
 		byteValue            = or(hexByteValue, octalByteValue)
@@ -118,11 +139,43 @@ func spec() (tokMatcher, errMatcher *scan.Matcher) {
 		UTF8Err                 = invalidUTF8
 		UTF8RuneErr             = con(`'`, invalidUTF8, `'`)
 		UTF8StrErr              = con(`"`, strValues, invalidUTF8, anyStrValues, `"`)
-		octalLitErr             = con(`0`, octalDigit.Repeat(), c(`89`), decimalDigit.Repeat())
-		hexLitErr               = con(`0`, c(`xX`))
-		strEscapeErr            = con(`"`, strValues, wrongEscape, anyStrValues, `"`).Exclude(bigUStrErr)
-		runeEscapeErr           = con(`'`, wrongEscape, `'`)
-		illegalRuneErr          = con(`'`, or(``, con(runeValue, anyRuneValue.AtLeast(1))), `'`)
+		decimalOrSep            = or(decimalDigit, `_`).Repeat()
+		hexOrSep                = or(hexDigit, `_`).Repeat()
+		invalidDigitErr         = or(
+			con(`0`, c(`bB`), decimalOrSep, b('2', '9'), decimalOrSep),
+			con(`0`, c(`oO`).Optional(), decimalOrSep, c(`89`), decimalOrSep))
+		// a number with the valid digits but misplaced separators
+		sepDecimalExponent = con(c("eE"), c("+-").Optional(), sepDigits(decimalDigit))
+		sepDecimalFloatLit = or(
+			con(decimalDigit, decimalOrSep, `.`, decimalOrSep, sepDecimalExponent.Optional()),
+			con(decimalDigit, decimalOrSep, sepDecimalExponent),
+			con(`.`, decimalDigit, decimalOrSep, sepDecimalExponent.Optional()))
+		sepHexMantissa = or(
+			con(sepDigits(hexDigit), con(`.`, hexOrSep).Optional()),
+			con(hexOrSep, `.`, sepDigits(hexDigit)))
+		sepHexFloatLit = con(`0`, c("xX"), sepHexMantissa, c("pP"), c("+-").Optional(), sepDigits(decimalDigit))
+		sepIntLit      = or(
+			con(b('1', '9'), decimalOrSep),
+			con(`0`, or(octalDigit, `_`).Repeat()),
+			con(`0`, c("bB"), sepDigits(binaryDigit)),
+			con(`0`, c("oO"), sepDigits(octalDigit)),
+			con(`0`, c("xX"), sepDigits(hexDigit)))
+		sepFloatLit     = or(sepDecimalFloatLit, sepHexFloatLit)
+		sepImaginaryLit = con(or(con(decimalDigit, decimalOrSep), sepIntLit, sepFloatLit), `i`)
+		digitSepErr     = or(sepIntLit, sepFloatLit, sepImaginaryLit).Exclude(numberLit)
+		// any other malformed number scanned as far as go/scanner does
+		numberErr = or(
+			con(`0`, c("xX"), hexOrSep, con(`.`, hexOrSep).Optional(),
+				con(c("pP"), c("+-").Optional(), decimalOrSep).Optional(), c(`i`).Optional()),
+			con(or(
+				con(`0`, c("oObB"), decimalOrSep, con(`.`, decimalOrSep).Optional()),
+				con(decimalDigit, decimalOrSep, con(`.`, decimalOrSep).Optional()),
+				con(`.`, decimalDigit, decimalOrSep)),
+				con(c("eEpP"), c("+-").Optional(), decimalOrSep).Optional(), c(`i`).Optional()),
+		).Exclude(numberLit, invalidDigitErr, digitSepErr)
+		strEscapeErr   = con(`"`, strValues, wrongEscape, anyStrValues, `"`).Exclude(bigUStrErr)
+		runeEscapeErr  = con(`'`, wrongEscape, `'`)
+		illegalRuneErr = con(`'`, or(``, con(runeValue, anyRuneValue.AtLeast(1))), `'`)
 	)
 	tokMatcher, errMatcher = scan.NewMatcher(
 		tEOF,
@@ -130,12 +183,37 @@ func spec() (tokMatcher, errMatcher *scan.Matcher) {
 		[]scan.MID{
 			{whitespaces, tWhitespace},
 			{"\n", tNewline},
+			{lineCommentInfo, tLineCommentInfo}, // before lineComment
 			{lineComment, tLineComment},
 			{lineCommentEOF, tLineCommentEOF},
-			{lineCommentInfo, tLineCommentInfo},
 			{generalCommentSL, tGeneralCommentSL},
 			{generalCommentML, tGeneralCommentML},
-			{identifier, tIdentifier},
+			{`break`, tBreak},
+			{`case`, tCase},
+			{`chan`, tChan},
+			{`const`, tConst},
+			{`continue`, tContinue},
+			{`default`, tDefault},
+			{`defer`, tDefer},
+			{`else`, tElse},
+			{`fallthrough`, tFallthrough},
+			{`for`, tFor},
+			{`func`, tFunc},
+			{`go`, tGo},
+			{`goto`, tGoto},
+			{`if`, tIf},
+			{`import`, tImport},
+			{`interface`, tInterface},
+			{`map`, tMap},
+			{`package`, tPackage},
+			{`range`, tRange},
+			{`return`, tReturn},
+			{`select`, tSelect},
+			{`struct`, tStruct},
+			{`switch`, tSwitch},
+			{`type`, tType},
+			{`var`, tVar},
+			{identifier, tIdentifier}, // after keywords
 			{intLit, tInt},
 			{floatLit, tFloat},
 			{imaginaryLit, tImag},
@@ -189,36 +267,13 @@ func spec() (tokMatcher, errMatcher *scan.Matcher) {
 			{`}`, tRightBrace},
 			{`;`, tSemiColon},
 			{`:`, tColon},
-			{`break`, tBreak},
-			{`case`, tCase},
-			{`chan`, tChan},
-			{`const`, tConst},
-			{`continue`, tContinue},
-			{`default`, tDefault},
-			{`defer`, tDefer},
-			{`else`, tElse},
-			{`fallthrough`, tFallthrough},
-			{`for`, tFor},
-			{`func`, tFunc},
-			{`go`, tGo},
-			{`goto`, tGoto},
-			{`if`, tIf},
-			{`import`, tImport},
-			{`interface`, tInterface},
-			{`map`, tMap},
-			{`package`, tPackage},
-			{`range`, tRange},
-			{`return`, tReturn},
-			{`select`, tSelect},
-			{`struct`, tStruct},
-			{`switch`, tSwitch},
-			{`type`, tType},
-			{`var`, tVar},
+			{`~`, tTilde},
 
 			// error patterns that have to be ORed with token patterns
 			{incompleteCommentErr, eIncompleteComment},
-			{octalLitErr, eOctalLit},
-			{hexLitErr, eHexLit},
+			{invalidDigitErr, eInvalidDigit},
+			{digitSepErr, eDigitSep},
+			{numberErr, eNumber},
 		}),
 		scan.NewMatcher(
 			eErrorEOF,
@@ -248,18 +303,6 @@ func spec() (tokMatcher, errMatcher *scan.Matcher) {
 				{UTF8RuneErr, eUTF8Rune},
 				{UTF8StrErr, eUTF8Str},
 			})
-	f, _ := os.Create("cache.go")
-	fmt.Fprint(f, `
-package scanner
-import (
-	"h12.io/dfa"
-	"h12.io/gombi/scan"
-)
-`)
-	fmt.Fprintln(f, "var tokMatcherCache = ")
-	tokMatcher.WriteGo(f, "scanner")
-	fmt.Fprintln(f, "var errMatcherCache = ")
-	errMatcher.WriteGo(f, "scanner")
 	return
 }
 
@@ -269,11 +312,11 @@ var (
 )
 
 func initMatcher() {
-	gTokenMatcher, gErrorMatcher = spec()
-	if !enableCache {
-		fmt.Println(gTokenMatcher.Count())
-		fmt.Println(gErrorMatcher.Count())
+	if enableCache {
+		gTokenMatcher, gErrorMatcher = tokMatcherCache.Init(), errMatcherCache.Init()
+		return
 	}
+	gTokenMatcher, gErrorMatcher = spec()
 }
 
 func getTokenMatcher() *scan.Matcher {
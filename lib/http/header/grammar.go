@@ -0,0 +1,158 @@
+package header
+
+import (
+	"h12.io/gombi/lib/http/internal/grammar"
+	"h12.io/gombi/parse"
+	"h12.io/gombi/scan"
+)
+
+// The grammars of RFC 7230, 7231, 7234, 6265, 7239 and 8288.
+var (
+	token        = term("token")
+	quotedString = term("quoted-string")
+	uriReference = term("uri-reference")
+	cookieValue  = term("cookie-value")
+	avValue      = term("av-value")
+	ows          = term("OWS")
+
+	name  = newRule().As("name").Ref(token)
+	value = or(token, quotedString).As("value")
+
+	parameter = con(name, "=", value).As("parameter")
+	mediaType = con(newRule().As("type").Ref(token), "/", newRule().As("subtype").Ref(token),
+		con(paramSep, parameter).ZeroOrMore()).As("media-type")
+	contentType = con(mediaType, eof).As("Content-Type")
+	accept      = con(list(mediaType), eof).As("Accept")
+
+	directive    = con(name, con("=", value).Optional()).As("directive")
+	cacheControl = con(list1(directive), eof).As("Cache-Control")
+
+	// unlike the other parameters, the pairs of RFC 7239 are separated by ";"
+	// without OWS.
+	forwardedElement = con(parameter, con(";", parameter).ZeroOrMore()).As("forwarded-element")
+	forwarded        = con(list1(forwardedElement), eof).As("Forwarded")
+
+	linkParam = con(name, con("=", value).Optional()).As("link-param")
+	linkValue = con(uriReference, con(paramSep, linkParam).ZeroOrMore()).As("link-value")
+	link      = con(list(linkValue), eof).As("Link")
+
+	cookiePair = con(name, "=", newRule().As("value").Ref(cookieValue).Optional()).As("cookie-pair")
+	cookie     = con(cookiePair, con(";", cookiePair).ZeroOrMore(), eof).As("Cookie")
+	attribute  = con(name, con("=", newRule().As("value").Ref(avValue).Optional()).Optional()).As("attribute")
+	setCookie  = con(cookiePair, con(";", attribute).ZeroOrMore(), eof).As("Set-Cookie")
+)
+
+const (
+	tEOF = iota
+	tIllegal
+	tOWS
+	tToken
+	tQuotedString
+	tURIReference
+	tComma
+	tSemicolon
+	tEquals
+	tSlash
+	tCookieSep
+	tCookieValue
+	tAVValue
+	tokenCount
+)
+
+var (
+	// tokenTable maps the token IDs to the terminals. OWS is a terminal, so
+	// that it is only allowed where the grammars have it.
+	tokenTable = grammar.TokenTable(tokenCount, []grammar.TT{
+		{tOWS, ows},
+		{tToken, token},
+		{tQuotedString, quotedString},
+		{tURIReference, uriReference},
+		{tComma, term(",")},
+		{tSemicolon, term(";")},
+		{tEquals, term("=")},
+		{tSlash, term("/")},
+		{tCookieSep, term(";")},
+		{tCookieValue, cookieValue},
+		{tAVValue, avValue},
+		{tEOF, eof},
+	})
+
+	listModes, cookieModes, setCookieModes = newModes()
+)
+
+func init() {
+	for _, r := range []*parse.R{contentType, accept, cacheControl, forwarded, link, cookie, setCookie} {
+		r.InitTermSet()
+	}
+}
+
+// newModes returns the lexer modes of the headers. The list based headers are
+// scanned in a single mode, while a cookie value and a Set-Cookie attribute
+// value are scanned in their own modes after "=".
+func newModes() (list, cookie, setCookie *scan.Modes) {
+	var (
+		c   = scan.Char
+		b   = scan.Between
+		bb  = scan.BetweenByte
+		s   = scan.Str
+		or  = scan.Or
+		con = scan.Con
+
+		DIGIT    = b('0', '9')
+		ALPHA    = or(b('a', 'z'), b('A', 'Z'))
+		VCHAR    = bb(0x21, 0x7E)
+		SP       = s(" ")
+		HTAB     = s("\t")
+		DQUOTE   = s(`"`)
+		OWS      = or(SP, HTAB).AtLeast(1)
+		obsText  = bb(0x80, 0xFF)
+		HEXDIG   = or(DIGIT, b('A', 'F'), b('a', 'f'))
+		tchar    = or(c("!#$%&'*+-.^_`|~"), DIGIT, ALPHA)
+		qdtext   = or(HTAB, SP, s("\x21"), bb(0x23, 0x5B), bb(0x5D, 0x7E), obsText)
+		quoted   = con(`\`, or(HTAB, SP, VCHAR, obsText))
+		uriChar  = or(ALPHA, DIGIT, c(`-._~:/?#[]@!$&'()*+,;=`), con(`%`, HEXDIG, HEXDIG))
+		cookieCh = or(s("\x21"), bb(0x23, 0x2B), bb(0x2D, 0x3A), bb(0x3C, 0x5B), bb(0x5D, 0x7E))
+		avChar   = bb(0x20, 0x7E).Exclude(`;`)
+
+		tokenPat        = tchar.AtLeast(1)
+		quotedStringPat = con(DQUOTE, or(qdtext, quoted).Repeat(), DQUOTE)
+		uriPat          = con(`<`, uriChar.Repeat(), `>`)
+		cookieValuePat  = or(cookieCh.AtLeast(1), con(DQUOTE, cookieCh.Repeat(), DQUOTE))
+		avValuePat      = avChar.AtLeast(1)
+
+		listMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{OWS, tOWS},
+			{tokenPat, tToken},
+			{quotedStringPat, tQuotedString},
+			{uriPat, tURIReference},
+			{`,`, tComma},
+			{`;`, tSemicolon},
+			{`=`, tEquals},
+			{`/`, tSlash},
+		})
+		// a cookie is strictly separated by "; " without any other space
+		nameMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{tokenPat, tToken},
+			{`=`, tEquals},
+			{`; `, tCookieSep},
+		})
+		cookieValueMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{cookieValuePat, tCookieValue},
+			{`; `, tCookieSep},
+		})
+		avValueMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{avValuePat, tAVValue},
+			{`; `, tCookieSep},
+		})
+	)
+	list = scan.NewModes("list").Add("list", listMatcher)
+	cookie = scan.NewModes("name").
+		Add("name", nameMatcher, scan.Switch(tEquals, "value")).
+		Add("value", cookieValueMatcher, scan.Switch(tCookieSep, "name"))
+	setCookie = scan.NewModes("name").
+		Add("name", nameMatcher, scan.Switch(tEquals, "value")).
+		Add("value", cookieValueMatcher, scan.Switch(tCookieSep, "attribute")).
+		Add("attribute", nameMatcher, scan.Switch(tEquals, "av")).
+		Add("av", avValueMatcher, scan.Switch(tCookieSep, "attribute"))
+	return
+}
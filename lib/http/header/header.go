@@ -0,0 +1,406 @@
+// Package header parses HTTP header values strictly according to their
+// grammars in the RFCs.
+package header
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"h12.io/gombi/lib/http/internal/grammar"
+	"h12.io/gombi/parse"
+)
+
+// Error is an invalid part of a header value that is not a syntax error,
+// which is reported as a parse.ErrorList instead.
+type Error = grammar.Error
+
+// Param is a name and an unquoted value. The value is empty for a parameter
+// without one, e.g. the no-cache directive of Cache-Control.
+type Param struct {
+	Name  string
+	Value string
+}
+
+// Params are parameters in the order of a header value.
+type Params []Param
+
+// Get returns the value of the first parameter with name compared case
+// insensitively, and whether it is found.
+func (ps Params) Get(name string) (string, bool) {
+	for _, p := range ps {
+		if strings.EqualFold(p.Name, name) {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+func (p *Param) UnmarshalNode(n *parse.Node) error {
+	var v struct {
+		Name  string `gombi:"name"`
+		Value string `gombi:"value"`
+	}
+	if err := n.Unmarshal(&v); err != nil {
+		return err
+	}
+	p.Name, p.Value = v.Name, unquote(v.Value)
+	return nil
+}
+
+// param is a Param with the position of the node bound to it.
+type param struct {
+	Param
+	pos int
+}
+
+func (p *param) UnmarshalNode(n *parse.Node) error {
+	p.pos = n.Pos()
+	return p.Param.UnmarshalNode(n)
+}
+
+func toParams(ps []param) Params {
+	if len(ps) == 0 {
+		return nil
+	}
+	params := make(Params, len(ps))
+	for i := range ps {
+		params[i] = ps[i].Param
+	}
+	return params
+}
+
+// MediaType is the value of Content-Type, or a media range of Accept.
+type MediaType struct {
+	Type    string
+	Subtype string
+	Params  Params
+}
+
+// MediaRange is a media range of Accept with its weight. The parameters after
+// the weight are the accept extensions, which must not contain another weight.
+type MediaRange struct {
+	MediaType
+	Q   float64 // 1 if there is no weight
+	Ext Params
+}
+
+var (
+	contentTypeGrammar  = newGrammar(contentType, listModes)
+	acceptGrammar       = newGrammar(accept, listModes)
+	cacheControlGrammar = newGrammar(cacheControl, listModes)
+	forwardedGrammar    = newGrammar(forwarded, listModes)
+	linkGrammar         = newGrammar(link, listModes)
+	cookieGrammar       = newGrammar(cookie, cookieModes)
+	setCookieGrammar    = newGrammar(setCookie, setCookieModes)
+)
+
+// ParseContentType parses a Content-Type header value of RFC 7231.
+func ParseContentType(s string) (*MediaType, error) {
+	var v struct {
+		MediaType mediaRange `gombi:"media-type"`
+	}
+	if err := unmarshal(contentTypeGrammar, s, &v); err != nil {
+		return nil, err
+	}
+	mt := v.MediaType.MediaType
+	mt.Params = toParams(v.MediaType.params)
+	return &mt, nil
+}
+
+// ParseAccept parses an Accept header value of RFC 7231, which can be empty.
+func ParseAccept(s string) ([]MediaRange, error) {
+	var v struct {
+		Ranges []mediaRange `gombi:"media-type"`
+	}
+	if err := unmarshal(acceptGrammar, s, &v); err != nil {
+		return nil, err
+	}
+	rs := make([]MediaRange, len(v.Ranges))
+	for i, r := range v.Ranges {
+		mr, err := r.toMediaRange()
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = mr
+	}
+	return rs, nil
+}
+
+type mediaRange struct {
+	MediaType
+	params []param
+}
+
+func (r *mediaRange) UnmarshalNode(n *parse.Node) error {
+	var v struct {
+		Type    string  `gombi:"type"`
+		Subtype string  `gombi:"subtype"`
+		Params  []param `gombi:"parameter"`
+	}
+	if err := n.Unmarshal(&v); err != nil {
+		return err
+	}
+	r.Type, r.Subtype, r.params = v.Type, v.Subtype, v.Params
+	return nil
+}
+
+var qvalue = regexp.MustCompile(`^(0(\.[0-9]{0,3})?|1(\.0{0,3})?)$`)
+
+func (r *mediaRange) toMediaRange() (MediaRange, error) {
+	mr := MediaRange{MediaType: r.MediaType, Q: 1}
+	for i, p := range r.params {
+		if !strings.EqualFold(p.Name, "q") {
+			continue
+		}
+		if !qvalue.MatchString(p.Value) {
+			return mr, &Error{Pos: p.pos, Msg: fmt.Sprintf("invalid q-value %q", p.Value)}
+		}
+		mr.Q, _ = strconv.ParseFloat(p.Value, 64)
+		mr.Params = toParams(r.params[:i])
+		ext := r.params[i+1:]
+		for _, e := range ext {
+			if strings.EqualFold(e.Name, "q") {
+				return mr, &Error{Pos: e.pos, Msg: fmt.Sprintf("duplicate parameter %s", e.Name)}
+			}
+		}
+		mr.Ext = toParams(ext)
+		return mr, nil
+	}
+	mr.Params = toParams(r.params)
+	return mr, nil
+}
+
+// ParseCacheControl parses a Cache-Control header value of RFC 7234. The
+// arguments of the directives max-age, s-maxage, max-stale and min-fresh must
+// be delta-seconds.
+func ParseCacheControl(s string) (Params, error) {
+	var v struct {
+		Directives []param `gombi:"directive"`
+	}
+	if err := unmarshal(cacheControlGrammar, s, &v); err != nil {
+		return nil, err
+	}
+	for _, d := range v.Directives {
+		switch strings.ToLower(d.Name) {
+		case "max-stale":
+			if d.Value == "" {
+				continue // the argument is optional
+			}
+			fallthrough
+		case "max-age", "s-maxage", "min-fresh":
+			if !isDigits(d.Value) {
+				return nil, &Error{Pos: d.pos, Msg: fmt.Sprintf("invalid delta-seconds %q of %s", d.Value, d.Name)}
+			}
+		}
+	}
+	return toParams(v.Directives), nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Forwarded is a forwarded-element of RFC 7239.
+type Forwarded struct {
+	For    string
+	By     string
+	Host   string
+	Proto  string
+	Params Params // all the pairs including the ones above
+}
+
+// ParseForwarded parses a Forwarded header value of RFC 7239. A parameter
+// must not occur more than once in an element.
+func ParseForwarded(s string) ([]Forwarded, error) {
+	var v struct {
+		Elements []struct {
+			Pairs []param `gombi:"parameter"`
+		} `gombi:"forwarded-element"`
+	}
+	if err := unmarshal(forwardedGrammar, s, &v); err != nil {
+		return nil, err
+	}
+	fs := make([]Forwarded, len(v.Elements))
+	for i, e := range v.Elements {
+		f := &fs[i]
+		for j, p := range e.Pairs {
+			if _, dup := toParams(e.Pairs[:j]).Get(p.Name); dup {
+				return nil, &Error{Pos: p.pos, Msg: fmt.Sprintf("duplicate parameter %s", p.Name)}
+			}
+			switch strings.ToLower(p.Name) {
+			case "for":
+				f.For = p.Value
+			case "by":
+				f.By = p.Value
+			case "host":
+				f.Host = p.Value
+			case "proto":
+				f.Proto = p.Value
+			}
+		}
+		f.Params = toParams(e.Pairs)
+	}
+	return fs, nil
+}
+
+// Link is a link-value of RFC 8288.
+type Link struct {
+	URI    string // the URI reference without the angle brackets
+	Params Params
+}
+
+// Rel returns the relation type of the link.
+func (l *Link) Rel() string {
+	rel, _ := l.Params.Get("rel")
+	return rel
+}
+
+// ParseLink parses a Link header value of RFC 8288, which can be empty.
+func ParseLink(s string) ([]Link, error) {
+	var v struct {
+		Links []linkItem `gombi:"link-value"`
+	}
+	if err := unmarshal(linkGrammar, s, &v); err != nil {
+		return nil, err
+	}
+	ls := make([]Link, len(v.Links))
+	for i, l := range v.Links {
+		if _, err := url.Parse(l.URI); err != nil {
+			return nil, &Error{Pos: l.pos, Msg: fmt.Sprintf("invalid URI reference %q", l.URI)}
+		}
+		ls[i] = l.Link
+	}
+	return ls, nil
+}
+
+type linkItem struct {
+	Link
+	pos int
+}
+
+func (l *linkItem) UnmarshalNode(n *parse.Node) error {
+	var v struct {
+		URI    string  `gombi:"uri-reference"`
+		Params []param `gombi:"link-param"`
+	}
+	if err := n.Unmarshal(&v); err != nil {
+		return err
+	}
+	l.pos = n.Pos()
+	l.URI, l.Params = v.URI[1:len(v.URI)-1], toParams(v.Params)
+	return nil
+}
+
+// Cookie is a cookie-pair of RFC 6265 with the value unquoted.
+type Cookie struct {
+	Name  string
+	Value string
+}
+
+func (c *Cookie) UnmarshalNode(n *parse.Node) error {
+	return (*Param)(c).UnmarshalNode(n)
+}
+
+// ParseCookie parses a Cookie header value of RFC 6265, in which the cookies
+// are separated by exactly "; ".
+func ParseCookie(s string) ([]Cookie, error) {
+	var v struct {
+		Cookies []Cookie `gombi:"cookie-pair"`
+	}
+	if err := unmarshal(cookieGrammar, s, &v); err != nil {
+		return nil, err
+	}
+	return v.Cookies, nil
+}
+
+// SetCookie is the value of a Set-Cookie header of RFC 6265.
+type SetCookie struct {
+	Cookie
+	Expires  time.Time
+	MaxAge   int // 0 if there is no Max-Age, < 0 if Max-Age is not positive
+	Domain   string
+	Path     string
+	Secure   bool
+	HTTPOnly bool
+	SameSite string
+	Attrs    Params // all the attributes including the ones above
+}
+
+// ParseSetCookie parses a Set-Cookie header value of RFC 6265. The known
+// attributes are checked, i.e. Expires must be an RFC 1123 date in GMT,
+// Max-Age must be an integer, Secure and HttpOnly must have no value, and
+// SameSite must be Strict, Lax or None.
+func ParseSetCookie(s string) (*SetCookie, error) {
+	var v struct {
+		Cookie Cookie  `gombi:"cookie-pair"`
+		Attrs  []param `gombi:"attribute"`
+	}
+	if err := unmarshal(setCookieGrammar, s, &v); err != nil {
+		return nil, err
+	}
+	c := &SetCookie{Cookie: v.Cookie, Attrs: toParams(v.Attrs)}
+	for _, a := range v.Attrs {
+		if err := c.set(a); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *SetCookie) set(a param) error {
+	invalid := func() error {
+		return &Error{Pos: a.pos, Msg: fmt.Sprintf("invalid %s attribute %q", a.Name, a.Value)}
+	}
+	switch strings.ToLower(a.Name) {
+	case "expires":
+		t, err := time.Parse(http.TimeFormat, a.Value)
+		if err != nil {
+			return invalid()
+		}
+		c.Expires = t
+	case "max-age":
+		n, err := strconv.Atoi(a.Value)
+		if err != nil {
+			return invalid()
+		}
+		if n <= 0 {
+			n = -1
+		}
+		c.MaxAge = n
+	case "domain":
+		c.Domain = a.Value
+	case "path":
+		c.Path = a.Value
+	case "secure":
+		if a.Value != "" {
+			return invalid()
+		}
+		c.Secure = true
+	case "httponly":
+		if a.Value != "" {
+			return invalid()
+		}
+		c.HTTPOnly = true
+	case "samesite":
+		switch strings.ToLower(a.Value) {
+		case "strict", "lax", "none":
+		default:
+			return invalid()
+		}
+		c.SameSite = a.Value
+	}
+	return nil
+}
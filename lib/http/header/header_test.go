@@ -0,0 +1,202 @@
+package header
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"h12.io/gombi/parse"
+	"h12.io/gspec"
+)
+
+func TestSpec(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, root := range []*parse.R{contentType, accept, cacheControl, forwarded, link, cookie, setCookie} {
+		expect(root.Name(), parse.Analyze(root).Issues.Err()).Equal(nil)
+	}
+}
+
+func TestContentType(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	mt, err := ParseContentType(`text/html; charset="utf-8";q=x`)
+	expect(err).Equal(nil)
+	expect(*mt).Equal(MediaType{Type: "text", Subtype: "html", Params: Params{{"charset", "utf-8"}, {"q", "x"}}})
+	v, ok := mt.Params.Get("Charset")
+	expect(v).Equal("utf-8")
+	expect(ok).Equal(true)
+	mt, err = ParseContentType("text/html ;\tcharset=utf-8")
+	expect(err).Equal(nil)
+	expect(*mt).Equal(MediaType{Type: "text", Subtype: "html", Params: Params{{"charset", "utf-8"}}})
+
+	for _, c := range []struct {
+		s   string
+		err string
+	}{
+		{"text", "4: unexpected EOF, expected /"},
+		{"text/html, text/plain", "9: unexpected ,, expected ; or EOF or OWS"},
+		{"text/html; charset", "18: unexpected EOF, expected ="},
+		{"text/html; a=b c", `15: unexpected token "c", expected ;`},
+		{`text/html; a="b`, `13: illegal character '"'`},
+		{"text/h\x00tml", `6: illegal character '\x00'`},
+		{"text / html", "4: unexpected OWS \" \", expected /"},
+		{"text/html; charset = utf-8", "18: unexpected OWS \" \", expected ="},
+	} {
+		_, err := ParseContentType(c.s)
+		expect(c.s, err).NotEqual(nil)
+		expect(c.s, err.Error()).Equal(c.err)
+	}
+}
+
+func TestAccept(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	rs, err := ParseAccept(`text/*;level=1;q=0.5;ext="a\"b", , */*;q=0, application/json`)
+	expect(err).Equal(nil)
+	expect(rs).Equal([]MediaRange{
+		{MediaType: MediaType{"text", "*", Params{{"level", "1"}}}, Q: 0.5, Ext: Params{{"ext", `a"b`}}},
+		{MediaType: MediaType{"*", "*", nil}, Q: 0},
+		{MediaType: MediaType{"application", "json", nil}, Q: 1},
+	})
+	rs, err = ParseAccept("")
+	expect(err).Equal(nil)
+	expect(len(rs)).Equal(0)
+
+	for _, c := range []struct {
+		s   string
+		err string
+	}{
+		{"text/html;q=1.5", `10: invalid q-value "1.5"`},
+		{"text/html;Q=0.1234", `10: invalid q-value "0.1234"`},
+		{"text/html;q=", "12: unexpected EOF, expected quoted-string or token"},
+		{"text/html;q=0.5;a=b;Q=1", `20: duplicate parameter Q`},
+	} {
+		_, err := ParseAccept(c.s)
+		expect(c.s, err).NotEqual(nil)
+		expect(c.s, err.Error()).Equal(c.err)
+	}
+}
+
+func TestCacheControl(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	ds, err := ParseCacheControl(`no-cache, private="Set-Cookie", max-age=60,max-stale`)
+	expect(err).Equal(nil)
+	expect(ds).Equal(Params{{"no-cache", ""}, {"private", "Set-Cookie"}, {"max-age", "60"}, {"max-stale", ""}})
+
+	for _, c := range []struct {
+		s   string
+		err string
+	}{
+		{"", "0: unexpected EOF, expected , or token"},
+		{"max-age=1m", `0: invalid delta-seconds "1m" of max-age`},
+		{`public, s-maxage="x"`, `8: invalid delta-seconds "x" of s-maxage`},
+	} {
+		_, err := ParseCacheControl(c.s)
+		expect(c.s, err).NotEqual(nil)
+		expect(c.s, err.Error()).Equal(c.err)
+	}
+}
+
+func TestForwarded(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	fs, err := ParseForwarded(`for="[2001:db8:cafe::17]:4711";proto=https, for=192.0.2.43;By=203.0.113.60;host=example.com`)
+	expect(err).Equal(nil)
+	expect(fs).Equal([]Forwarded{
+		{For: "[2001:db8:cafe::17]:4711", Proto: "https", Params: Params{{"for", "[2001:db8:cafe::17]:4711"}, {"proto", "https"}}},
+		{For: "192.0.2.43", By: "203.0.113.60", Host: "example.com", Params: Params{{"for", "192.0.2.43"}, {"By", "203.0.113.60"}, {"host", "example.com"}}},
+	})
+
+	_, err = ParseForwarded("for=a;FOR=b")
+	expect(err.Error()).Equal("6: duplicate parameter FOR")
+	_, err = ParseForwarded("for=[::1]")
+	expect(err.Error()).Equal(`4: illegal character '['`)
+}
+
+func TestLink(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	ls, err := ParseLink(`<https://example.com/?page=2>; rel="next"; title*=UTF-8'de'n%c3%a4chstes, </style.css>;rel=preload;nopush`)
+	expect(err).Equal(nil)
+	expect(ls).Equal([]Link{
+		{URI: "https://example.com/?page=2", Params: Params{{"rel", "next"}, {"title*", "UTF-8'de'n%c3%a4chstes"}}},
+		{URI: "/style.css", Params: Params{{"rel", "preload"}, {"nopush", ""}}},
+	})
+	expect(ls[0].Rel()).Equal("next")
+
+	_, err = ParseLink("<http://[::1>")
+	expect(err.Error()).Equal(`0: invalid URI reference "http://[::1"`)
+	_, err = ParseLink("<a b>")
+	expect(err.Error()).Equal(`0: illegal character '<'`)
+}
+
+func TestCookie(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	cs, err := ParseCookie(`a=b/c=d; e="f"; g=`)
+	expect(err).Equal(nil)
+	expect(cs).Equal([]Cookie{{"a", "b/c=d"}, {"e", "f"}, {"g", ""}})
+
+	for _, c := range []struct {
+		s   string
+		err string
+	}{
+		{"a=b;c=d", `3: illegal character ';'`},
+		{"a=b;  c=d", `5: illegal character ' '`},
+		{"a=b c", `3: illegal character ' '`},
+		{"a", "1: unexpected EOF, expected ="},
+	} {
+		_, err := ParseCookie(c.s)
+		expect(c.s, err).NotEqual(nil)
+		expect(c.s, err.Error()).Equal(c.err)
+	}
+}
+
+func TestSetCookie(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	c, err := ParseSetCookie("id=a3fWa; Expires=Wed, 21 Oct 2015 07:28:00 GMT; Max-Age=0; Domain=example.com; Path=/docs; Secure; HttpOnly; SameSite=Lax; Priority=High")
+	expect(err).Equal(nil)
+	expect(*c).Equal(SetCookie{
+		Cookie:   Cookie{"id", "a3fWa"},
+		Expires:  time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC),
+		MaxAge:   -1,
+		Domain:   "example.com",
+		Path:     "/docs",
+		Secure:   true,
+		HTTPOnly: true,
+		SameSite: "Lax",
+		Attrs: Params{
+			{"Expires", "Wed, 21 Oct 2015 07:28:00 GMT"}, {"Max-Age", "0"}, {"Domain", "example.com"},
+			{"Path", "/docs"}, {"Secure", ""}, {"HttpOnly", ""}, {"SameSite", "Lax"}, {"Priority", "High"},
+		},
+	})
+
+	for _, c := range []struct {
+		s   string
+		err string
+	}{
+		{"a=b; Expires=21 Oct 2015", `5: invalid Expires attribute "21 Oct 2015"`},
+		{"a=b; max-age=1d", `5: invalid max-age attribute "1d"`},
+		{"a=b; Secure=1", `5: invalid Secure attribute "1"`},
+		{"a=b; SameSite=Loose", `5: invalid SameSite attribute "Loose"`},
+		{"a=b; Path=/;x", `11: illegal character ';'`},
+	} {
+		_, err := ParseSetCookie(c.s)
+		expect(c.s, err).NotEqual(nil)
+		expect(c.s, err.Error()).Equal(c.err)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if _, err := ParseAccept("text/html;q=0.9, */*;q=0.1"); err != nil {
+					t.Error(err)
+				}
+				if _, err := ParseSetCookie("a=b; Path=/; Secure"); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,70 @@
+package header
+
+import (
+	"strings"
+
+	"h12.io/gombi/lib/http/internal/grammar"
+	"h12.io/gombi/parse"
+	"h12.io/gombi/scan"
+)
+
+var (
+	builder = parse.NewBuilder()
+	term    = builder.Term
+	or      = builder.Or
+	con     = builder.Con
+	newRule = parse.NewRule
+	eof     = parse.EOF
+
+	// list returns the #rule of RFC 7230, a comma separated list that can be
+	// empty and can contain empty elements. OWS is only allowed before a comma
+	// and between a comma and the next element.
+	list = func(elem *parse.R) *parse.R {
+		return con(elem.Optional(), listSep(elem).ZeroOrMore())
+	}
+	// list1 returns the 1#rule of RFC 7230, a list of at least one element.
+	list1 = func(elem *parse.R) *parse.R {
+		return con(con(",", ows.Optional()).ZeroOrMore(), elem, listSep(elem).ZeroOrMore())
+	}
+	// listSep returns OWS "," [ OWS elem ], an element after a comma.
+	listSep = func(elem *parse.R) *parse.R {
+		return con(ows.Optional(), ",", con(ows.Optional(), elem).Optional())
+	}
+	// paramSep is OWS ";" OWS before a parameter of RFC 7231 and RFC 8288.
+	paramSep = con(ows.Optional(), ";", ows.Optional())
+)
+
+// newGrammar returns the grammar of a header with its root rule and lexer
+// modes.
+func newGrammar(root *parse.R, modes *scan.Modes) *grammar.Grammar {
+	return grammar.New(root, modes, tokenTable, tIllegal)
+}
+
+// unmarshal parses a header value and binds the result to v.
+func unmarshal(g *grammar.Grammar, s string, v interface{}) error {
+	n, err := g.Parse(s)
+	if err != nil {
+		return err
+	}
+	return n.Unmarshal(v)
+}
+
+// unquote returns the content of a quoted-string with the quoted-pairs
+// unescaped, or s itself if it is not quoted.
+func unquote(s string) string {
+	if len(s) < 2 || s[0] != '"' {
+		return s
+	}
+	s = s[1 : len(s)-1]
+	if strings.IndexByte(s, '\\') < 0 {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
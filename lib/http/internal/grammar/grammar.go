@@ -0,0 +1,95 @@
+// Package grammar parses a header value with a parse.R over the tokens of a
+// scan.Scanner with lexer modes, shared by the packages of lib/http.
+package grammar
+
+import (
+	"fmt"
+	"sync"
+
+	"h12.io/gombi/parse"
+	"h12.io/gombi/scan"
+)
+
+// Error is an invalid part of a header value that is not a syntax error,
+// which is reported as a parse.ErrorList instead.
+type Error struct {
+	Pos int // the byte offset in the header value
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Pos, e.Msg)
+}
+
+// TT binds a token ID to a terminal.
+type TT struct {
+	Token int
+	Term  *parse.R
+}
+
+// TokenTable maps the token IDs less than size to the terminals, the tokens
+// without a terminal are skipped by Parse.
+func TokenTable(size int, tt []TT) []*parse.R {
+	t := make([]*parse.R, size)
+	for i := range tt {
+		t[tt[i].Token] = tt[i].Term
+	}
+	return t
+}
+
+// Grammar is the root rule, the lexer modes and the token table of a header,
+// with a pool of scanners and parsers, which are not cheap to build, so that
+// the header can be parsed concurrently.
+type Grammar struct {
+	tokens  []*parse.R
+	illegal int
+	pool    sync.Pool
+}
+
+type parser struct {
+	scanner scan.Scanner
+	parser  *parse.Parser
+}
+
+// New returns a Grammar of the root rule, which must be initialized by
+// InitTermSet. illegal is the token ID of an illegal character.
+func New(root *parse.R, modes *scan.Modes, tokens []*parse.R, illegal int) *Grammar {
+	g := &Grammar{tokens: tokens, illegal: illegal}
+	g.pool.New = func() interface{} {
+		p := &parser{parser: parse.New(root)}
+		p.scanner.SetModes(modes)
+		return p
+	}
+	return g
+}
+
+// Parse parses a header value into a parse tree. An illegal character is
+// reported as an *Error and a syntax error as a parse.ErrorList.
+func (g *Grammar) Parse(s string) (*parse.Node, error) {
+	p := g.pool.Get().(*parser)
+	defer g.pool.Put(p)
+	src := []byte(s)
+	p.scanner.SetSource(src)
+	p.parser.Reset()
+	for p.scanner.Scan() {
+		t := p.scanner.Token()
+		if t.ID == g.illegal {
+			return nil, &Error{Pos: t.Lo, Msg: fmt.Sprintf("illegal character %q", src[t.Lo])}
+		}
+		r := g.tokens[t.ID]
+		if r == nil {
+			continue // e.g. white space
+		}
+		if !p.parser.Parse(&parse.Token{ID: t.ID, Value: p.scanner.Bytes(), Pos: t.Lo}, r) {
+			break
+		}
+	}
+	if err := p.parser.Error(); err != nil {
+		return nil, err
+	}
+	results := p.parser.Results()
+	if len(results) == 0 {
+		return nil, &Error{Pos: len(src), Msg: "incomplete value"}
+	}
+	return results[0], nil
+}
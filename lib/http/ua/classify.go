@@ -0,0 +1,246 @@
+package ua
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Class is the classification of a user agent.
+type Class struct {
+	Browser Name
+	Engine  Name // the rendering engine
+	OS      Name
+	Device  Device
+	Crawler bool // the browser is a crawler and the device is Bot
+}
+
+// Name is a family and its version, both are empty if unknown.
+type Name struct {
+	Family  string
+	Version string
+}
+
+type Device int
+
+const (
+	Desktop Device = iota
+	Mobile
+	Tablet
+	Bot
+)
+
+var deviceNames = []string{
+	Desktop: "desktop",
+	Mobile:  "mobile",
+	Tablet:  "tablet",
+	Bot:     "bot",
+}
+
+func (d Device) String() string {
+	if d >= 0 && int(d) < len(deviceNames) {
+		return deviceNames[d]
+	}
+	return fmt.Sprintf("Device(%d)", int(d))
+}
+
+// Rule matches the products and comments of a user agent. All the regular
+// expressions of a rule must match, and a rule without any matches every user
+// agent. The version is the first submatch of the comments, or else the
+// version of the product matched by Version, or else the version of the
+// product matched by Product. An underscore in a version is replaced by a dot.
+type Rule struct {
+	Name    string   `json:"name"`              // the family, or the device name
+	Product string   `json:"product,omitempty"` // the name of a product
+	Comment []string `json:"comment,omitempty"` // each matches an item of the comments
+	Version string   `json:"version,omitempty"` // the name of a product
+
+	product *regexp.Regexp
+	comment []*regexp.Regexp
+	version *regexp.Regexp
+	device  Device
+}
+
+// Rules are the rule lists of a classification, the first matching rule of
+// each list wins. When a crawler is matched, it is the browser and the device
+// rules are not used.
+type Rules struct {
+	Crawlers []Rule `json:"crawlers"`
+	Browsers []Rule `json:"browsers"`
+	Engines  []Rule `json:"engines"`
+	OS       []Rule `json:"os"`
+	Devices  []Rule `json:"devices"`
+}
+
+//go:embed rules.json
+var defaultRulesFile []byte
+
+// DefaultRules are the rules in rules.json used by Classify.
+var DefaultRules = mustLoadRules(defaultRulesFile)
+
+func mustLoadRules(data []byte) *Rules {
+	rs, err := LoadRules(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// LoadRules reads rules in JSON, in the same format as rules.json.
+func LoadRules(r io.Reader) (*Rules, error) {
+	var rs Rules
+	if err := json.NewDecoder(r).Decode(&rs); err != nil {
+		return nil, err
+	}
+	for _, list := range [][]Rule{rs.Crawlers, rs.Browsers, rs.Engines, rs.OS, rs.Devices} {
+		for i := range list {
+			if err := list[i].compile(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i := range rs.Devices {
+		r := &rs.Devices[i]
+		d := deviceByName(r.Name)
+		if d < 0 {
+			return nil, fmt.Errorf("ua: unknown device %q", r.Name)
+		}
+		r.device = d
+	}
+	return &rs, nil
+}
+
+func deviceByName(name string) Device {
+	for d, s := range deviceNames {
+		if s == name {
+			return Device(d)
+		}
+	}
+	return -1
+}
+
+func (r *Rule) compile() (err error) {
+	if r.product, err = compile(r.Product); err != nil {
+		return err
+	}
+	if r.version, err = compile(r.Version); err != nil {
+		return err
+	}
+	r.comment = make([]*regexp.Regexp, len(r.Comment))
+	for i, s := range r.Comment {
+		if r.comment[i], err = compile(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compile(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("ua: invalid rule: %v", err)
+	}
+	return re, nil
+}
+
+// Classify classifies a user agent with DefaultRules.
+func Classify(ua string) (*Class, error) {
+	return DefaultRules.Classify(ua)
+}
+
+// Classify classifies a user agent with the rules.
+func (rs *Rules) Classify(ua string) (*Class, error) {
+	ps, err := ParseUserAgent(ua)
+	if err != nil {
+		return nil, err
+	}
+	var items []string
+	for _, p := range ps {
+		items = p.Comment.appendItems(items)
+	}
+	c := &Class{}
+	c.Engine, _ = first(rs.Engines, ps, items)
+	c.OS, _ = first(rs.OS, ps, items)
+	if name, r := first(rs.Crawlers, ps, items); r != nil {
+		c.Browser, c.Device, c.Crawler = name, Bot, true
+		return c, nil
+	}
+	c.Browser, _ = first(rs.Browsers, ps, items)
+	if _, r := first(rs.Devices, ps, items); r != nil {
+		c.Device = r.device
+	}
+	return c, nil
+}
+
+// appendItems appends the items of c and its nested comments to items.
+func (c *Comment) appendItems(items []string) []string {
+	items = append(items, c.Items...)
+	for i := range c.Comments {
+		items = c.Comments[i].appendItems(items)
+	}
+	return items
+}
+
+// first returns the name of the first matching rule and the rule, or nil if
+// no rule matches.
+func first(rules []Rule, ps []*Product, items []string) (Name, *Rule) {
+	for i := range rules {
+		if version, ok := rules[i].match(ps, items); ok {
+			return Name{Family: rules[i].Name, Version: version}, &rules[i]
+		}
+	}
+	return Name{}, nil
+}
+
+func (r *Rule) match(ps []*Product, items []string) (version string, ok bool) {
+	var p *Product
+	if r.product != nil {
+		if p = findProduct(r.product, ps); p == nil {
+			return "", false
+		}
+	}
+	for _, re := range r.comment {
+		m := findItem(re, items)
+		if m == nil {
+			return "", false
+		}
+		if version == "" && len(m) > 1 {
+			version = m[1]
+		}
+	}
+	if version == "" && r.version != nil {
+		if v := findProduct(r.version, ps); v != nil {
+			version = v.Version.Text
+		}
+	}
+	if version == "" && p != nil {
+		version = p.Version.Text
+	}
+	return strings.ReplaceAll(version, "_", "."), true
+}
+
+func findProduct(re *regexp.Regexp, ps []*Product) *Product {
+	for _, p := range ps {
+		if re.MatchString(p.Name) {
+			return p
+		}
+	}
+	return nil
+}
+
+// findItem returns the submatches of the first matching item.
+func findItem(re *regexp.Regexp, items []string) []string {
+	for _, item := range items {
+		if m := re.FindStringSubmatch(item); m != nil {
+			return m
+		}
+	}
+	return nil
+}
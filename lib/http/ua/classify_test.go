@@ -0,0 +1,113 @@
+package ua
+
+import (
+	"strings"
+	"testing"
+
+	"h12.io/gspec"
+)
+
+func TestClassify(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, c := range []struct {
+		ua   string
+		want Class
+	}{
+		{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Safari/537.36",
+			Class{Browser: Name{"Chrome", "120.0.6099.109"}, Engine: Name{"Blink", "120.0.6099.109"}, OS: Name{"Windows", "10.0"}, Device: Desktop},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			Class{Browser: Name{"Edge", "120.0.2210.91"}, Engine: Name{"Blink", "120.0.0.0"}, OS: Name{"Windows", "10.0"}, Device: Desktop},
+		},
+		{
+			"Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:31.0) Gecko/20100101 Firefox/31.0",
+			Class{Browser: Name{"Firefox", "31.0"}, Engine: Name{"Gecko", "31.0"}, OS: Name{"Linux", ""}, Device: Desktop},
+		},
+		{
+			"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			Class{Browser: Name{"Safari", "17.1"}, Engine: Name{"WebKit", "605.1.15"}, OS: Name{"Mac OS X", "10.15.7"}, Device: Desktop},
+		},
+		{
+			"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+			Class{Browser: Name{"Safari", "17.1"}, Engine: Name{"WebKit", "605.1.15"}, OS: Name{"iOS", "17.1.2"}, Device: Mobile},
+		},
+		{
+			"Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/119.0.6045.169 Mobile/15E148 Safari/604.1",
+			Class{Browser: Name{"Chrome", "119.0.6045.169"}, Engine: Name{"WebKit", "605.1.15"}, OS: Name{"iOS", "16.6"}, Device: Tablet},
+		},
+		{
+			"Mozilla/5.0 (Linux; Android 13; SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+			Class{Browser: Name{"Samsung Internet", "23.0"}, Engine: Name{"Blink", "115.0.0.0"}, OS: Name{"Android", "13"}, Device: Mobile},
+		},
+		{
+			"Mozilla/5.0 (Linux; Android 12; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			Class{Browser: Name{"Chrome", "120.0.0.0"}, Engine: Name{"Blink", "120.0.0.0"}, OS: Name{"Android", "12"}, Device: Tablet},
+		},
+		{
+			"Mozilla/5.0 (Linux; U; Android 4.0.3; ko-kr; LG-L160L Build/IML74K) AppleWebkit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30",
+			Class{Browser: Name{"Android Browser", "4.0"}, OS: Name{"Android", "4.0.3"}, Device: Mobile},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; rv:11.0) like Gecko",
+			Class{Browser: Name{"IE", "11.0"}, Engine: Name{"Trident", "7.0"}, OS: Name{"Windows", "6.1"}, Device: Desktop},
+		},
+		{
+			"Mozilla/4.0 (compatible; MSIE 6.0; Windows NT 5.1)",
+			Class{Browser: Name{"IE", "6.0"}, OS: Name{"Windows", "5.1"}, Device: Desktop},
+		},
+		{
+			"Opera/9.80 (Windows NT 6.0) Presto/2.12.388 Version/12.14",
+			Class{Browser: Name{"Opera", "12.14"}, Engine: Name{"Presto", "2.12.388"}, OS: Name{"Windows", "6.0"}, Device: Desktop},
+		},
+		{
+			"Mozilla/5.0 (X11; CrOS x86_64 14541.0.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			Class{Browser: Name{"Chrome", "120.0.0.0"}, Engine: Name{"Blink", "120.0.0.0"}, OS: Name{"Chrome OS", "14541.0.0"}, Device: Desktop},
+		},
+		{
+			"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			Class{Browser: Name{"Googlebot", "2.1"}, Device: Bot, Crawler: true},
+		},
+		{
+			"Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm) Chrome/116.0.1938.76 Safari/537.36",
+			Class{Browser: Name{"Bingbot", "2.0"}, Engine: Name{"Blink", "116.0.1938.76"}, Device: Bot, Crawler: true},
+		},
+		{
+			"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php) SomeCrawler/3",
+			Class{Browser: Name{"crawler", "3"}, Device: Bot, Crawler: true},
+		},
+		{
+			"curl/8.4.0",
+			Class{Device: Desktop},
+		},
+	} {
+		class, err := Classify(c.ua)
+		expect(c.ua, err).Equal(nil)
+		expect(c.ua, *class).Equal(c.want)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	_, err := Classify("Mozilla/5.0 (Windows")
+	expect(err).NotEqual(nil)
+}
+
+func TestLoadRules(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	rs, err := LoadRules(strings.NewReader(`{
+		"browsers": [{"name": "Tool", "product": "^(curl|Wget)$"}],
+		"devices": [{"name": "bot", "product": "^(curl|Wget)$"}]
+	}`))
+	expect(err).Equal(nil)
+	class, err := rs.Classify("curl/8.4.0")
+	expect(err).Equal(nil)
+	expect(*class).Equal(Class{Browser: Name{"Tool", "8.4.0"}, Device: Bot})
+	expect(Bot.String()).Equal("bot")
+
+	_, err = LoadRules(strings.NewReader(`{"devices": [{"name": "watch"}]}`))
+	expect(err.Error()).Equal(`ua: unknown device "watch"`)
+	_, err = LoadRules(strings.NewReader(`{"os": [{"name": "x", "comment": ["("]}]}`))
+	expect(err.Error()).Equal("ua: invalid rule: error parsing regexp: missing closing ): `(`")
+}
@@ -1,29 +1,29 @@
 package ua
 
 import (
-	"io"
-
-	"h12.io/gombi/experiment/gre/scan"
-	"h12.io/gombi/parse"
+	"h12.io/gombi/lib/http/internal/grammar"
+	"h12.io/gombi/scan"
 )
 
+// The grammar of RFC 2616, see spec.md.
 var (
-	userAgent      = rule("user-agent", or(product, comment).As("item").OneOrMore())
-	product        = rule("product", productName, con(productSep, productVersion).ZeroOrOne())
-	productName    = rule("product-name", productToken)
-	productVersion = rule("product-version", productToken)
-	productToken   = term("product-token")
+	userAgent      = or(product, comment).AtLeast(1).As("user-agent")
+	product        = con(productName, con(productSep, productVersion).Optional()).As("product")
+	productName    = newRule().As("product-name").Ref(productToken)
+	productVersion = newRule().As("product-version").Ref(productToken)
+	productToken   = term("token")
 	productSep     = term("/")
-	comment        = rule("comment", leftParen, or(commentText, self("comment")).ZeroOrMore(), rightParen)
-	leftParen      = term(`"("`)
-	rightParen     = term(`")"`)
+	comment        = newRule().As("comment")
+	_              = comment.Define(con(leftParen, or(commentText, comment).ZeroOrMore(), rightParen))
+	leftParen      = term("(")
+	rightParen     = term(")")
 	commentText    = term("ctext")
-	parser         = parse.NewParser(userAgent)
-	scanner        = newScanner()
+	root           = con(userAgent, eof).As("User-Agent")
 )
 
 const (
 	tEOF = iota
+	tIllegal
 	tProductToken
 	tProductSep
 	tLWS
@@ -35,110 +35,71 @@ const (
 )
 
 var (
-	TokenTable = TTable(tokenCount, []TT{
+	// tokenTable maps the token IDs to the terminals, the tokens without a
+	// terminal are skipped.
+	tokenTable = grammar.TokenTable(tokenCount, []grammar.TT{
 		{tProductToken, productToken},
 		{tProductSep, productSep},
 		{tLeftParen, leftParen},
 		{tRightParen, rightParen},
 		{tCommentText, commentText},
-		{tEOF, parse.EOF},
+		{tEOF, eof},
 	})
-)
-
-func newScanner() *switchScanner {
-	var (
-		c     = scan.Char
-		merge = scan.Merge
-		or    = scan.Or
-		con   = scan.Con
+	modes = newModes()
 
-		CHAR  = c(`\x00-\x7F`)
-		OCTET = c(`\x00-\xFF`)
-		CR    = c(`\r`)
-		LF    = c(`\n`)
-		CRLF  = con(CR, LF)
-		SP    = c(` `)
-		HT    = c(`\t`)
-		LWS   = con(CRLF.ZeroOrOne(), or(SP, HT).OneOrMore())
-		CTL   = c(`\x00-\x1F\x7F`)
-		//TEXT  = or(OCTET.Exclude(CTL), LWS)
+	userAgentGrammar = grammar.New(root, modes, tokenTable, tIllegal)
+)
 
-		separators   = merge(c(`\(\)<>@,;:\\"/\[\]\?=\{\}`), SP, HT)
-		token        = CHAR.Exclude(CTL, separators).OneOrMore()
-		quotedPair   = con(c(`\\`), CHAR)
-		ctext        = or(OCTET.Exclude(CTL, c(`()`), c(`;`)), LWS)
-		leftParen    = c(`(`)
-		rightParen   = c(`)`)
-		productToken = token
-		productSep   = c(`/`)
-		commentSep   = or(c(`;`), LWS).OneOrMore()
-		commentText  = or(ctext, quotedPair).OneOrMore()
+func init() {
+	root.InitTermSet()
+}
 
-		m = scan.NewMatcher(
-			productToken,
-			productSep,
-			LWS,
-			leftParen,
-		)
-		mc = scan.NewMapMatcher(scan.MM{
-			{leftParen, tLeftParen},
-			{rightParen, tRightParen},
+// newModes returns the lexer modes of a user agent, a comment is scanned in
+// the comment mode, which is pushed by each nested left parenthesis and popped
+// by a right parenthesis.
+func newModes() *scan.Modes {
+	var (
+		c   = scan.Char
+		bb  = scan.BetweenByte
+		s   = scan.Str
+		or  = scan.Or
+		con = scan.Con
+
+		CHAR = bb(0, 0x7F)
+		CR   = s("\r")
+		LF   = s("\n")
+		CRLF = con(CR, LF)
+		SP   = s(" ")
+		HT   = s("\t")
+		LWS  = con(CRLF.Optional(), or(SP, HT).AtLeast(1))
+		CTL  = or(bb(0, 0x1F), s("\x7F"))
+		TEXT = or(bb(0x20, 0x7E), bb(0x80, 0xFF)) // without LWS
+
+		separators = or(c(`()<>@,;:\"/[]?={}`), SP, HT)
+		token      = CHAR.Exclude(CTL, separators).AtLeast(1)
+		quotedPair = con(`\`, CHAR)
+		// a comment text is split into items by ";" and LWS around it, but
+		// LWS within an item is kept.
+		ctext       = TEXT.Exclude(c(`()\;`), SP)
+		word        = or(ctext, quotedPair).AtLeast(1)
+		commentText = con(word, con(LWS, word).Repeat())
+		commentSep  = or(`;`, LWS).AtLeast(1)
+
+		productMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{token, tProductToken},
+			{`/`, tProductSep},
+			{LWS, tLWS},
+			{`(`, tLeftParen},
+			{`)`, tRightParen},
+		})
+		commentMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{`(`, tLeftParen},
+			{`)`, tRightParen},
 			{commentSep, tCommentSep},
 			{commentText, tCommentText},
 		})
-
-		scanner = &switchScanner{scan.Scanner{Matcher: m}, m, mc, 0}
 	)
-	return scanner
-}
-
-type switchScanner struct {
-	scan.Scanner
-	m      *scan.Matcher
-	mc     *scan.Matcher
-	clevel int
-}
-
-func (s *switchScanner) parserToken() (*parse.Token, *parse.R) {
-	t := s.Scanner.Token()
-	return &parse.Token{t.Value, t.Pos}, TokenTable[t.ID]
-}
-
-func (s *switchScanner) SetReader(r io.Reader) {
-	s.Matcher = s.m
-	s.Scanner.SetReader(r)
-}
-
-func (s *switchScanner) Scan() bool {
-	if s.Scanner.Scan() {
-		switch s.Token().ID {
-		case tLeftParen:
-			s.clevel++
-			if s.clevel > 0 {
-				s.Matcher = s.mc
-			}
-		case tRightParen:
-			s.clevel--
-			if s.clevel == 0 {
-				s.Matcher = s.m
-			}
-		case tLWS, tCommentSep:
-			return s.Scan() // skip
-		}
-		return true
-	}
-	return false
-}
-
-type TT struct {
-	Token int
-	Term  *parse.R
-}
-
-func TTable(size int, tt []TT) []*parse.R {
-	t := make([]*parse.R, size)
-	for i := range tt {
-		t[tt[i].Token] = tt[i].Term
-	}
-	return t
+	return scan.NewModes("product").
+		Add("product", productMatcher, scan.Push(tLeftParen, "comment")).
+		Add("comment", commentMatcher, scan.Push(tLeftParen, "comment"), scan.Pop(tRightParen))
 }
@@ -1,73 +1,72 @@
 package ua
 
 import (
+	"strconv"
 	"strings"
 
 	"h12.io/gombi/parse"
 )
 
 type Product struct {
-	Name    string
-	Version Version
-	Comment Comment
+	Name    string  `gombi:"product-name"`
+	Version Version `gombi:"product-version"`
+	Comment Comment // the comments following the product
 }
 type Version struct {
 	Text    string
-	Numbers []int
+	Numbers []int // the leading dot separated numbers of Text
 }
 type Comment struct {
-	Items    []string
-	Comments []Comment
+	Items    []string  `gombi:"ctext"`
+	Comments []Comment `gombi:"comment"`
 }
 
+// ParseUserAgent parses the value of a User-Agent header into products. The
+// comments before the first product are attached to an empty product. It is
+// safe to be called concurrently.
 func ParseUserAgent(s string) ([]*Product, error) {
-	scanner.SetReader(strings.NewReader(s))
-	parser.Reset()
-	for scanner.Scan() &&
-		parser.Parse(scanner.parserToken()) {
+	n, err := userAgentGrammar.Parse(s)
+	if err != nil {
+		return nil, err
 	}
-	if scanner.Error() != nil {
-		return nil, scanner.Error()
-	}
-	if parser.Error() != nil {
-		return nil, parser.Error()
-	}
-	r := parser.Results()[0]
-
 	ps := []*Product{}
-	p := &Product{}
-	r.Child(0).Each(func(item *parse.Node) {
+	var errs []error
+	n.Child(0).EachItem(func(item *parse.Node) {
 		item = item.Child(0) // from (product | comment) to product or comment
 		if item.Is(product) {
-			p = newProduct(item)
+			p := &Product{}
+			errs = append(errs, item.Unmarshal(p))
 			ps = append(ps, p)
-		} else {
-			p.Comment = p.Comment.append(item)
+			return
+		}
+		if len(ps) == 0 {
+			ps = append(ps, &Product{})
 		}
+		var c Comment
+		errs = append(errs, item.Unmarshal(&c))
+		ps[len(ps)-1].Comment.append(c)
 	})
-	if len(ps) == 0 {
-		ps = append(ps, p)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
 	}
 	return ps, nil
 }
 
-func newProduct(productNode *parse.Node) *Product {
-	return &Product{
-		Name: productNode.Get(productName),
-		Version: Version{
-			Text: productNode.Get(productVersion),
-		},
+func (v *Version) UnmarshalNode(n *parse.Node) error {
+	v.Text = string(n.Child(0).Value())
+	for _, s := range strings.Split(v.Text, ".") {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			break
+		}
+		v.Numbers = append(v.Numbers, i)
 	}
+	return nil
 }
 
-func (c Comment) append(commentNode *parse.Node) Comment {
-	commentNode.Child(1).Each(func(citem *parse.Node) {
-		citem = citem.Child(0)
-		if citem.Is(commentText) {
-			c.Items = append(c.Items, citem.Get(commentText))
-		} else if citem.Is(comment) {
-			c.Comments = append(c.Comments, Comment{}.append(citem))
-		}
-	})
-	return c
+func (c *Comment) append(o Comment) {
+	c.Items = append(c.Items, o.Items...)
+	c.Comments = append(c.Comments, o.Comments...)
 }
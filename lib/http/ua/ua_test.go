@@ -3,10 +3,12 @@ package ua
 import (
 	"reflect"
 	"strconv"
+	"sync"
 	"testing"
 
-	"h12.io/gspec"
 	"github.com/ogdl/flow"
+	"h12.io/gombi/parse"
+	"h12.io/gspec"
 )
 
 var testcases = []struct {
@@ -19,7 +21,8 @@ var testcases = []struct {
 	{`*Product/1.0`, []*Product{
 		{Name: "*Product",
 			Version: Version{
-				Text: "1.0",
+				Text:    "1.0",
+				Numbers: []int{1, 0},
 			}},
 	}},
 	{`()`, []*Product{
@@ -47,7 +50,8 @@ var testcases = []struct {
 			{
 				Name: "Mozilla",
 				Version: Version{
-					Text: "5.0",
+					Text:    "5.0",
+					Numbers: []int{5, 0},
 				},
 				Comment: Comment{
 					Items: []string{"X11", "Ubuntu", "Linux x86_64", "rv:31.0"},
@@ -56,13 +60,15 @@ var testcases = []struct {
 			{
 				Name: "Gecko",
 				Version: Version{
-					Text: "20100101",
+					Text:    "20100101",
+					Numbers: []int{20100101},
 				},
 			},
 			{
 				Name: "Firefox",
 				Version: Version{
-					Text: "31.0",
+					Text:    "31.0",
+					Numbers: []int{31, 0},
 				},
 			},
 		},
@@ -81,6 +87,63 @@ var _ = gspec.Add(func(s gspec.S) {
 	}
 })
 
+func TestSpec(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	expect(parse.Analyze(root).Issues.Err()).Equal(nil)
+}
+
+func TestComment(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	r, err := ParseUserAgent("(a) B/x.1 ( c\\)d ;;e\t f ) (g)")
+	expect(err).Equal(nil)
+	expect(r).Equal([]*Product{
+		{Comment: Comment{Items: []string{"a"}}},
+		{
+			Name:    "B",
+			Version: Version{Text: "x.1"},
+			Comment: Comment{Items: []string{"c\\)d", "e\t f", "g"}},
+		},
+	})
+}
+
+func TestError(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, c := range []struct {
+		s   string
+		err string
+	}{
+		{"", "0: unexpected EOF, expected ( or token"},
+		{"A/", "2: unexpected EOF, expected token"},
+		{"A/1)", "3: unexpected ), expected ( or EOF or token"},
+		{"(a", "2: unexpected EOF, expected ( or ) or ctext"},
+		{"A;1", `1: illegal character ';'`},
+	} {
+		_, err := ParseUserAgent(c.s)
+		expect(err).NotEqual(nil)
+		expect(err.Error()).Equal(c.err)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				for _, tc := range testcases {
+					r, err := ParseUserAgent(tc.str)
+					if err != nil || !reflect.DeepEqual(r, tc.ua) {
+						t.Errorf("%s: got %v, %v", tc.str, r, err)
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func TestAll(t *testing.T) {
 	gspec.Test(t)
 }
@@ -1,28 +1,12 @@
 package ua
 
-import (
-	"fmt"
-	"reflect"
-
-	"h12.io/gombi/parse"
-	ogdl "github.com/ogdl/flow"
-)
+import "h12.io/gombi/parse"
 
 var (
-	term = parse.Term
-	rule = parse.Rule
-	or   = parse.Or
-	con  = parse.Con
-	self = parse.Self
+	builder = parse.NewBuilder()
+	term    = builder.Term
+	or      = builder.Or
+	con     = builder.Con
+	newRule = parse.NewRule
+	eof     = parse.EOF
 )
-
-func op(v interface{}) {
-	buf, _ := ogdl.MarshalIndent(v, "    ", "    ")
-	typ := ""
-	if v != nil {
-		typ = reflect.TypeOf(v).String() + "\n"
-	}
-	fmt.Println("\n" +
-		typ +
-		string(buf))
-}
@@ -0,0 +1,152 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"h12.io/gspec"
+)
+
+func TestScanner(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	s := NewScanner(strings.NewReader(" {\"a\": [1, -2.5e3, true, false, null]}\n"))
+	var kinds []Kind
+	var values []string
+	for s.Scan() {
+		t := s.Token()
+		kinds = append(kinds, t.Kind)
+		values = append(values, string(t.Value))
+	}
+	expect(s.Err()).Equal(nil)
+	expect(kinds).Equal([]Kind{BeginObject, String, NameSeparator, BeginArray,
+		Number, ValueSeparator, Number, ValueSeparator, True, ValueSeparator,
+		False, ValueSeparator, Null, EndArray, EndObject})
+	expect(values[1]).Equal(`"a"`)
+	expect(values[6]).Equal("-2.5e3")
+}
+
+func TestParse(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, text := range []string{
+		`0`,
+		`-0.0e+0`,
+		`"a\"\\\/\b\f\n\r\té𝄞€"`,
+		`[]`,
+		`{}`,
+		`[1, [2, [3]], {"a": {}}]`,
+		`{"a": 1, "b": [true, false, null], "c": {"d": "e"}, "a": 2}`,
+		" \t\r\n{ \"x\" : 1.5E-3 } \n",
+	} {
+		var want interface{}
+		if err := json.Unmarshal([]byte(text), &want); err != nil {
+			t.Fatal(err)
+		}
+		got, err := Parse([]byte(text))
+		if err != nil {
+			t.Fatalf("%s: %v", text, err)
+		}
+		expect(text, got).Equal(want)
+		got, err = ParseReader(iotest.OneByteReader(strings.NewReader(text)))
+		if err != nil {
+			t.Fatalf("%s: %v", text, err)
+		}
+		expect(text, got).Equal(want)
+	}
+}
+
+func TestError(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, c := range []struct {
+		text string
+		err  string
+	}{
+		{``, `1:1: unexpected EOF, expected [ or false or null or number or string or true or {`},
+		{`[1, 2,]`, `1:7: unexpected "]", expected [ or false or null or number or string or true or {`},
+		{`{"a": 1,}`, `1:9: unexpected "}", expected string`},
+		{`[1 2]`, `1:4: unexpected "2", expected , or ]`},
+		{`[1] [2]`, `1:5: unexpected "[", expected EOF`},
+		{`[01]`, `1:3: unexpected "1", expected , or ]`},
+		{`[1.]`, `1:3: invalid character '.'`},
+		{`['a']`, `1:2: invalid character '\''`},
+		{`"\ud800"`, `1:8: lone surrogate in \u escape`},
+		{`"\ud800A"`, `1:8: lone surrogate in \u escape`},
+		{`"\udc00"`, `1:5: lone surrogate in \u escape`},
+		{`"\x"`, `1:3: invalid escape character 'x' in string literal`},
+		{"\"a\tb\"", `1:3: invalid character '\t' in string literal`},
+		{`"abc`, `1:5: unexpected EOF in string literal`},
+		{`tru`, `1:4: unexpected EOF in literal`},
+		{`1e999`, `1:1: number 1e999 out of range`},
+		{"{\n  \"a\": [\n    1,\n  }\n}", `4:3: unexpected "}", expected [ or false or null or number or string or true or {`},
+	} {
+		_, err := Parse([]byte(c.text))
+		if err == nil {
+			t.Fatalf("expect error for %q", c.text)
+		}
+		expect(c.text, err.Error()).Equal(c.err)
+		_, isSyntaxError := err.(*SyntaxError)
+		expect(c.text, isSyntaxError).Equal(true)
+		var v interface{}
+		if json.Unmarshal([]byte(c.text), &v) == nil && !strings.Contains(c.text, "ud") {
+			t.Fatalf("encoding/json accepts %q", c.text)
+		}
+	}
+}
+
+var benchText = func() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("[")
+	for i := 0; i < 1000; i++ {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(`{"id": 12345, "name": "gombi é", "tags": ["a", "b", "c"], "score": -1.25e2, "ok": true, "next": null}`)
+	}
+	buf.WriteString("]")
+	return buf.Bytes()
+}()
+
+func BenchmarkParse(b *testing.B) {
+	b.SetBytes(int64(len(benchText)))
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(benchText); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	b.SetBytes(int64(len(benchText)))
+	for i := 0; i < b.N; i++ {
+		var v interface{}
+		if err := json.Unmarshal(benchText, &v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScanner(b *testing.B) {
+	b.SetBytes(int64(len(benchText)))
+	for i := 0; i < b.N; i++ {
+		s := NewBytesScanner(benchText)
+		for s.Scan() {
+		}
+		if err := s.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecoderToken(b *testing.B) {
+	b.SetBytes(int64(len(benchText)))
+	for i := 0; i < b.N; i++ {
+		d := json.NewDecoder(bytes.NewReader(benchText))
+		for {
+			if _, err := d.Token(); err != nil {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package json
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"h12.io/gombi/parse"
+)
+
+var (
+	builder = parse.NewBuilder()
+	term    = builder.Term
+	or      = builder.Or
+	con     = builder.Con
+	newRule = parse.NewRule
+)
+
+// The grammar of RFC 8259, whose actions convert a JSON text into the same Go
+// values as encoding/json does for an interface{}.
+var (
+	value  = newRule().As("value")
+	_      = value.Define(or(object, array, str, number, trueLit, falseLit, nullLit))
+	object = con("{", member.SepBy(term(",")), "}").As("object").Action(func(v []interface{}) interface{} {
+		members := sepByItems(v[1])
+		m := make(map[string]interface{}, len(members))
+		for _, mv := range members {
+			mv := mv.([]interface{})
+			m[mv[0].(string)] = mv[2]
+		}
+		return m
+	})
+	member = con(str, ":", value).As("member")
+	array  = con("[", value.SepBy(term(",")), "]").As("array").Action(func(v []interface{}) interface{} {
+		return sepByItems(v[1])
+	})
+	str = term("string").Action(func(v []interface{}) interface{} {
+		return unquote(v[0].(*parse.Token).Value)
+	})
+	number = term("number").Action(func(v []interface{}) interface{} {
+		f, _ := strconv.ParseFloat(string(v[0].(*parse.Token).Value), 64)
+		return f
+	})
+	trueLit  = term("true").Action(func([]interface{}) interface{} { return true })
+	falseLit = term("false").Action(func([]interface{}) interface{} { return false })
+	nullLit  = term("null").Action(func([]interface{}) interface{} { return nil })
+	text     = con(value, parse.EOF).As("JSON-text").Action(func(v []interface{}) interface{} {
+		return v[0]
+	})
+
+	tokenTable = []*parse.R{
+		EOF:            parse.EOF,
+		BeginObject:    term("{"),
+		EndObject:      term("}"),
+		BeginArray:     term("["),
+		EndArray:       term("]"),
+		NameSeparator:  term(":"),
+		ValueSeparator: term(","),
+		String:         str,
+		Number:         number,
+		True:           trueLit,
+		False:          falseLit,
+		Null:           nullLit,
+	}
+)
+
+func init() {
+	text.InitTermSet()
+}
+
+// sepByItems returns the values of the items of a rule created by SepBy, i.e.
+// (r (sep r)*)?.
+func sepByItems(v interface{}) []interface{} {
+	if v == nil {
+		return []interface{}{}
+	}
+	vs := v.([]interface{})
+	rest := vs[1].([]interface{})
+	items := make([]interface{}, 1, 1+len(rest))
+	items[0] = vs[0]
+	for _, r := range rest {
+		items = append(items, r.([]interface{})[1])
+	}
+	return items
+}
+
+// unquote decodes a string token, which is always valid.
+func unquote(s []byte) string {
+	s = s[1 : len(s)-1]
+	if bytes.IndexByte(s, '\\') < 0 {
+		return string(s)
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch c = s[i]; c {
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			r := hex4(s[i+1:])
+			i += 4
+			if utf16.IsSurrogate(r) {
+				r = utf16.DecodeRune(r, hex4(s[i+3:]))
+				i += 6
+			}
+			var buf [utf8.UTFMax]byte
+			b.Write(buf[:utf8.EncodeRune(buf[:], r)])
+		default: // " \ /
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func hex4(s []byte) rune {
+	n, _ := strconv.ParseUint(string(s[:4]), 16, 32)
+	return rune(n)
+}
+
+var parsers = sync.Pool{New: func() interface{} {
+	return parse.New(text)
+}}
+
+// Parse parses a JSON text into the same Go values as encoding/json does for
+// an interface{}, i.e. map[string]interface{}, []interface{}, string, float64,
+// bool or nil. An error is returned as a *SyntaxError.
+func Parse(data []byte) (interface{}, error) {
+	return parseFrom(NewBytesScanner(data), false)
+}
+
+// ParseReader parses a JSON text read from r, see Parse. An error of r is
+// returned as is.
+func ParseReader(r io.Reader) (interface{}, error) {
+	return parseFrom(NewScanner(r), true)
+}
+
+// parseFrom parses the tokens from s, the values of the tokens are copied if
+// they are overwritten by the scanner, because a token is evaluated by its
+// action only after the next token is scanned.
+func parseFrom(s *Scanner, copyValues bool) (interface{}, error) {
+	p := parsers.Get().(*parse.Parser)
+	defer parsers.Put(p)
+	p.Reset()
+	for s.Scan() {
+		t := s.Token()
+		if t.Kind == Number && outOfRange(t.Value) {
+			return nil, s.syntaxError(t.Offset, "number %s out of range", t.Value)
+		}
+		if copyValues {
+			t.Value = append([]byte(nil), t.Value...)
+		}
+		if !p.Parse(&parse.Token{ID: int(t.Kind), Value: t.Value, Pos: t.Offset}, tokenTable[t.Kind]) {
+			break
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if p.Error() == nil && s.Token().Kind == EOF {
+		t := s.Token()
+		p.Parse(&parse.Token{Pos: t.Offset}, parse.EOF)
+	}
+	if errs := p.Errors(); len(errs) > 0 {
+		return nil, s.parseError(errs[0])
+	}
+	return p.Value(), nil
+}
+
+// outOfRange returns true if a number token cannot be converted to a float64,
+// which is only possible with an exponent or too many digits.
+func outOfRange(lit []byte) bool {
+	if bytes.IndexAny(lit, "eE") < 0 && len(lit) < 300 {
+		return false
+	}
+	_, err := strconv.ParseFloat(string(lit), 64)
+	return err != nil
+}
+
+func (s *Scanner) parseError(e *parse.Error) *SyntaxError {
+	found := "EOF"
+	if e.Term != parse.EOF {
+		found = strconv.Quote(string(e.Token.Value))
+		if e.Term == str {
+			found = "string " + found
+		}
+	}
+	expected := make([]string, len(e.Expected))
+	for i, r := range e.Expected {
+		expected[i] = r.Name()
+	}
+	return s.syntaxError(e.Token.Pos, "unexpected %s, expected %s", found, strings.Join(expected, " or "))
+}
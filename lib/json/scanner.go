@@ -0,0 +1,192 @@
+// Package json scans and parses JSON texts strictly according to RFC 8259,
+// reporting syntax errors with their lines and columns.
+package json
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+
+	"h12.io/gombi/scan"
+)
+
+// Kind is the kind of a token.
+type Kind int
+
+const (
+	EOF Kind = iota
+	Illegal
+	BeginObject    // {
+	EndObject      // }
+	BeginArray     // [
+	EndArray       // ]
+	NameSeparator  // :
+	ValueSeparator // ,
+	String
+	Number
+	True
+	False
+	Null
+	whitespaceKind
+	kindCount
+)
+
+var kindNames = []string{
+	EOF:            "EOF",
+	Illegal:        "Illegal",
+	BeginObject:    "{",
+	EndObject:      "}",
+	BeginArray:     "[",
+	EndArray:       "]",
+	NameSeparator:  ":",
+	ValueSeparator: ",",
+	String:         "string",
+	Number:         "number",
+	True:           "true",
+	False:          "false",
+	Null:           "null",
+	whitespaceKind: "whitespace",
+}
+
+func (k Kind) String() string {
+	if k >= 0 && k < kindCount {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+var matcher = spec()
+
+// Token is a token scanned by Scanner.
+type Token struct {
+	Kind   Kind
+	Offset int    // the byte offset in the input
+	Value  []byte // valid until the next call of Scan
+}
+
+// SyntaxError is an invalid JSON text at a position, the line and column are
+// 1-based and the column is in bytes.
+type SyntaxError struct {
+	Offset int
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// Scanner is a streaming scanner of JSON tokens, whitespace is skipped.
+type Scanner struct {
+	s     scan.Scanner
+	lines *scan.Lines
+	err   error
+}
+
+// NewScanner returns a scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	s := newScanner()
+	s.s.SetReader(r)
+	return s
+}
+
+// NewBytesScanner returns a scanner of the whole input src.
+func NewBytesScanner(src []byte) *Scanner {
+	s := newScanner()
+	s.s.SetSource(src)
+	return s
+}
+
+func newScanner() *Scanner {
+	s := &Scanner{s: scan.Scanner{Matcher: matcher}, lines: scan.NewLines(nil)}
+	s.s.SetLines(s.lines)
+	return s
+}
+
+// Scan advances to the next token, and returns false at EOF or when an error
+// occurs, see Err.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	for s.s.Scan() {
+		t := s.s.Token()
+		switch Kind(t.ID) {
+		case whitespaceKind:
+			continue
+		case Illegal:
+			s.err = s.illegal(t)
+			return false
+		case EOF:
+			return false
+		}
+		return true
+	}
+	s.err = s.s.Error()
+	return false
+}
+
+// Token returns the current token.
+func (s *Scanner) Token() Token {
+	t := s.s.Token()
+	return Token{Kind: Kind(t.ID), Offset: t.Lo, Value: s.s.Bytes()}
+}
+
+// Err returns the first error met by Scan, either a *SyntaxError or an error
+// of the reader, or nil at EOF.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// syntaxError returns a *SyntaxError at the offset off, which must have been
+// scanned.
+func (s *Scanner) syntaxError(off int, format string, args ...interface{}) *SyntaxError {
+	p := s.lines.Position(off)
+	return &SyntaxError{Offset: off, Line: p.Line, Column: p.Column, Msg: fmt.Sprintf(format, args...)}
+}
+
+var (
+	// highSurrogate matches the text of an illegal string token stopped
+	// after a high surrogate not followed by a low surrogate.
+	highSurrogate = regexp.MustCompile(`\\u[Dd][89ABab][0-9A-Fa-f]{2}(\\(u[Dd]?)?)?$`)
+	// lowSurrogate matches the text of an illegal string token stopped at
+	// the second digit of a low surrogate.
+	lowSurrogate = regexp.MustCompile(`\\u[Dd]$`)
+)
+
+// illegal returns the error of an illegal token, at the position where the
+// matcher stops.
+func (s *Scanner) illegal(t *scan.Token) *SyntaxError {
+	text := s.s.Bytes()
+	var what string
+	switch {
+	case len(text) == 0:
+		return s.syntaxError(t.Lo, "invalid character %s", s.quoteAt(t.Lo))
+	case text[0] == '"':
+		what = "string literal"
+		if highSurrogate.Match(text) || lowSurrogate.Match(text) && bytes.ContainsAny(s.s.Slice(t.Hi, t.Hi+1), "CDEFcdef") {
+			return s.syntaxError(t.Hi, "lone surrogate in \\u escape")
+		}
+		if text[len(text)-1] == '\\' {
+			return s.syntaxError(t.Hi, "invalid escape character %s in string literal", s.quoteAt(t.Hi))
+		}
+	case text[0] == '-' || '0' <= text[0] && text[0] <= '9':
+		what = "number"
+	default:
+		what = "literal"
+	}
+	if c := s.quoteAt(t.Hi); c != "EOF" {
+		return s.syntaxError(t.Hi, "invalid character %s in %s", c, what)
+	}
+	return s.syntaxError(t.Hi, "unexpected EOF in %s", what)
+}
+
+// quoteAt returns the quoted byte at off, or EOF.
+func (s *Scanner) quoteAt(off int) string {
+	if b := s.s.Slice(off, off+1); len(b) > 0 {
+		return fmt.Sprintf("%q", b[0])
+	}
+	return "EOF"
+}
@@ -0,0 +1,59 @@
+package json
+
+import (
+	"unicode/utf8"
+
+	"h12.io/gombi/scan"
+)
+
+// spec returns the matcher of the JSON tokens of RFC 8259. A string must be
+// valid UTF-8 and a \u escape of a surrogate must be a part of a surrogate
+// pair, so that any string token can be decoded without an error.
+func spec() *scan.Matcher {
+	var (
+		c   = scan.Char
+		b   = scan.Between
+		s   = scan.Str
+		or  = scan.Or
+		con = scan.Con
+
+		digit    = b('0', '9')
+		digit19  = b('1', '9')
+		hexDigit = or(digit, b('A', 'F'), b('a', 'f'))
+		d        = c("Dd")
+
+		surrogate = b(0xD800, 0xDFFF)
+		unescaped = b(0x20, utf8.MaxRune).Exclude(c(`"\`), surrogate)
+		// the \u escapes of the code points other than surrogates, and the
+		// surrogate pairs.
+		nonSurrogate = or(
+			con(hexDigit.Exclude(d), hexDigit, hexDigit, hexDigit),
+			con(d, b('0', '7'), hexDigit, hexDigit))
+		highSurrogate = con(d, c("89ABab"), hexDigit, hexDigit)
+		lowSurrogate  = con(d, c("CDEFcdef"), hexDigit, hexDigit)
+		uEscape       = or(
+			con(`\u`, nonSurrogate),
+			con(`\u`, highSurrogate, `\u`, lowSurrogate))
+		escape = or(con(`\`, c(`"\/bfnrt`)), uEscape)
+
+		str    = con(`"`, or(unescaped, escape).Repeat(), `"`)
+		number = con(s("-").Optional(), or(`0`, con(digit19, digit.Repeat())),
+			con(`.`, digit.AtLeast(1)).Optional(),
+			con(c("eE"), c("+-").Optional(), digit.AtLeast(1)).Optional())
+		whitespace = c(" \t\n\r").AtLeast(1)
+	)
+	return scan.NewMatcher(int(EOF), int(Illegal), []scan.MID{
+		{whitespace, int(whitespaceKind)},
+		{`{`, int(BeginObject)},
+		{`}`, int(EndObject)},
+		{`[`, int(BeginArray)},
+		{`]`, int(EndArray)},
+		{`:`, int(NameSeparator)},
+		{`,`, int(ValueSeparator)},
+		{str, int(String)},
+		{number, int(Number)},
+		{`true`, int(True)},
+		{`false`, int(False)},
+		{`null`, int(Null)},
+	})
+}
@@ -0,0 +1,70 @@
+package ogdl
+
+import (
+	"strings"
+)
+
+// Node is a node of an OGDL tree.
+type Node struct {
+	Value    string
+	Children []*Node
+}
+
+// Child returns the first child with the value, or nil if not found.
+func (n *Node) Child(value string) *Node {
+	for _, c := range n.Children {
+		if c.Value == value {
+			return c
+		}
+	}
+	return nil
+}
+
+// Get returns the node reached from n by the path of values, or nil if not
+// found.
+func (n *Node) Get(path ...string) *Node {
+	for _, v := range path {
+		if n = n.Child(v); n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// String returns the OGDL text of the children of n, one node per line
+// indented by two spaces, which can be parsed back into the same tree unless
+// a value contains a control character other than tab.
+func (n *Node) String() string {
+	var b strings.Builder
+	for _, c := range n.Children {
+		c.write(&b, "")
+	}
+	return b.String()
+}
+
+func (n *Node) write(b *strings.Builder, indent string) {
+	b.WriteString(indent)
+	b.WriteString(quote(n.Value))
+	b.WriteByte('\n')
+	for _, c := range n.Children {
+		c.write(b, indent+"  ")
+	}
+}
+
+// quote returns s as is if it is a valid unquoted string, otherwise quoted.
+func quote(s string) string {
+	if s != "" && !strings.HasPrefix(s, "//") && s[0] != '"' && !strings.ContainsAny(s, " \t,{}") && !hasControl(s) {
+		return s
+	}
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+	return `"` + s + `"`
+}
+
+func hasControl(s string) bool {
+	for _, r := range s {
+		if r < 0x20 || 0x7f <= r && r < 0xa0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package ogdl
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/ogdl/flow"
+	"h12.io/gspec"
+)
+
+func TestScanner(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	s := NewScanner(strings.NewReader("a b // c\n  \"d e\", f\n\n    g {h,\n  i}\nj\n"))
+	var kinds []Kind
+	var values []string
+	for s.Scan() {
+		t := s.Token()
+		kinds = append(kinds, t.Kind)
+		if t.Kind == String {
+			values = append(values, string(t.Value))
+		}
+	}
+	expect(s.Err()).Equal(nil)
+	expect(kinds).Equal([]Kind{
+		String, String, Newline,
+		Indent, String, Comma, String, Newline,
+		Indent, String, LeftBrace, String, Comma, String, RightBrace, Newline,
+		Dedent, Dedent, String, Newline,
+	})
+	expect(values).Equal([]string{"a", "b", `"d e"`, "f", "g", "h", "i", "j"})
+}
+
+func TestParse(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, c := range []struct {
+		text string
+		tree string
+	}{
+		{"", ""},
+		{"// only a comment", ""},
+		{"a b c", "a\n  b\n    c\n"},
+		{"a, b c, d", "a\nb\n  c\nd\n"},
+		{"a\n  b\n    c\n  d\ne\n", "a\n  b\n    c\n  d\ne\n"},
+		{"a\n\tb\n\t\tc\n\td", "a\n  b\n    c\n  d\n"},
+		{"  a\n  b\n", "a\nb\n"},
+		{"a b\n  c\n", "a\n  b\n    c\n"},
+		{"a, b\n  c\n", "a\nb\n  c\n"},
+		{"a {b, c d} e", "a\n  b\n  c\n    d\n  e\n"},
+		{"a {b,\n  c,\n}\n  d", "a\n  b\n  c\n  d\n"},
+		{"{a, b} c", "a\nb\nc\n"},
+		{"a {} b", "a\n  b\n"},
+		{"a //b\n\n  // c\n   \n  d // e", "a\n  d\n"},
+		{`"a b" "c\"\\" x"y\`, "\"a b\"\n  c\"\\\n    x\"y\\\n"},
+		{"http://h12.io a//b", "http://h12.io\n  a//b\n"},
+		{"a\r\n  b\r  c", "a\n  b\n  c\n"},
+	} {
+		n, err := Parse([]byte(c.text))
+		if err != nil {
+			t.Fatalf("%q: %v", c.text, err)
+		}
+		expect(c.text, n.String()).Equal(c.tree)
+		n, err = ParseReader(iotest.OneByteReader(strings.NewReader(c.text)))
+		if err != nil {
+			t.Fatalf("%q: %v", c.text, err)
+		}
+		expect(c.text, n.String()).Equal(c.tree)
+
+		// the text of a tree is parsed back into the same tree
+		m, err := Parse([]byte(n.String()))
+		if err != nil {
+			t.Fatalf("%q: %v", n.String(), err)
+		}
+		expect(c.text, m).Equal(n)
+	}
+}
+
+func TestGet(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	n, err := Parse([]byte("server {host h12.io, port 80}\nclient\n  timeout 30"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(n.Get("server", "port").Children[0].Value).Equal("80")
+	expect(n.Get("client", "timeout", "30").Children).Equal([]*Node(nil))
+	expect(n.Get("client", "port")).Equal((*Node)(nil))
+}
+
+func TestFlow(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	type sub struct {
+		A int
+		B string
+	}
+	v := struct {
+		Name string
+		Tags []string
+		Sub  sub
+	}{"x y", []string{"a", "b"}, sub{1, "q"}}
+	buf, err := flow.MarshalIndent(v, "", "    ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := Parse(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect(n.String()).Equal(`Name
+  "x y"
+Tags
+  a
+  b
+Sub
+  A
+    1
+  B
+    q
+`)
+}
+
+func TestError(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, c := range []struct {
+		text string
+		err  string
+	}{
+		{"a,", `1:3: unexpected newline, expected string or {`},
+		{"a, , b", `1:4: unexpected ,, expected string or {`},
+		{"a {b", `1:5: unexpected newline, expected , or string or { or }`},
+		{"a }", `1:3: unexpected }, expected , or newline or string or {`},
+		{"a\n  b\n c", `3:2: inconsistent indentation`},
+		{"a\n\tb\n  c", `3:3: inconsistent indentation`},
+		{`"ab`, `1:4: unexpected EOF in quoted string`},
+		{`"a\b"`, `1:4: invalid character 'b' in quoted string`},
+		{"a\x01", `1:2: invalid character '\x01'`},
+	} {
+		_, err := Parse([]byte(c.text))
+		if err == nil {
+			t.Fatalf("expect error for %q", c.text)
+		}
+		expect(c.text, err.Error()).Equal(c.err)
+		_, isSyntaxError := err.(*SyntaxError)
+		expect(c.text, isSyntaxError).Equal(true)
+	}
+}
@@ -0,0 +1,188 @@
+package ogdl
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"h12.io/gombi/parse"
+)
+
+var (
+	builder = parse.NewBuilder()
+	term    = builder.Term
+	or      = builder.Or
+	con     = builder.Con
+	newRule = parse.NewRule
+)
+
+// The grammar of OGDL over the tokens of Scanner. The actions never modify
+// the value of a child, because it might be shared by the alternatives.
+var (
+	str = term("string").Action(func(v []interface{}) interface{} {
+		return &Node{Value: unquote(v[0].(*parse.Token).Value)}
+	})
+	list  = newRule().As("list")
+	block = newRule().As("block")
+	// group ::= '{' (list ','?)? '}'
+	group = con("{", con(list, term(",").Optional()).Optional(), "}").As("group").Action(func(v []interface{}) interface{} {
+		if v[1] == nil {
+			return []*Node(nil)
+		}
+		return link(v[1].([]interface{})[0].([][]interface{}), nil)
+	})
+	// sequence ::= (string | group)+
+	sequence = newRule().As("sequence").Ref(or(str, group).AtLeast(1)).Action(func(v []interface{}) interface{} {
+		return v[0]
+	})
+	// list ::= sequence (',' sequence)*
+	_ = list.Define(con(sequence, con(",", sequence).ZeroOrMore())).Action(func(v []interface{}) interface{} {
+		rest := v[1].([]interface{})
+		seqs := make([][]interface{}, 1, 1+len(rest))
+		seqs[0] = v[0].([]interface{})
+		for _, r := range rest {
+			seqs = append(seqs, r.([]interface{})[1].([]interface{}))
+		}
+		return seqs
+	})
+	// line ::= list newline (indent block dedent)?
+	line = con(list, "newline", con("indent", block, "dedent").Optional()).As("line").Action(func(v []interface{}) interface{} {
+		var children []*Node
+		if v[2] != nil {
+			children = v[2].([]interface{})[1].([]*Node)
+		}
+		return link(v[0].([][]interface{}), children)
+	})
+	// block ::= line+
+	_ = block.Ref(line.AtLeast(1)).Action(func(v []interface{}) interface{} {
+		var nodes []*Node
+		for _, l := range v[0].([]interface{}) {
+			nodes = append(nodes, l.([]*Node)...)
+		}
+		return nodes
+	})
+	text = con(block.Optional(), parse.EOF).As("OGDL-text").Action(func(v []interface{}) interface{} {
+		root := &Node{}
+		if v[0] != nil {
+			root.Children = v[0].([]*Node)
+		}
+		return root
+	})
+
+	tokenTable = []*parse.R{
+		EOF:        parse.EOF,
+		String:     str,
+		Comma:      term(","),
+		LeftBrace:  term("{"),
+		RightBrace: term("}"),
+		Newline:    term("newline"),
+		Indent:     term("indent"),
+		Dedent:     term("dedent"),
+	}
+)
+
+func init() {
+	text.InitTermSet()
+}
+
+// link returns the top level nodes of the sequences, each being either a
+// *Node or the []*Node of a group. The nodes following a node in a sequence
+// become its children, and the ones following a group are at the same level as
+// the group. The children follow the last sequence.
+func link(seqs [][]interface{}, children []*Node) []*Node {
+	var nodes []*Node
+	for i, seq := range seqs {
+		var next []*Node
+		if i == len(seqs)-1 {
+			next = children
+		}
+		for j := len(seq) - 1; j >= 0; j-- {
+			switch item := seq[j].(type) {
+			case *Node:
+				cs := make([]*Node, 0, len(item.Children)+len(next))
+				next = []*Node{{Value: item.Value, Children: append(append(cs, item.Children...), next...)}}
+			case []*Node:
+				next = append(item[:len(item):len(item)], next...)
+			}
+		}
+		nodes = append(nodes, next...)
+	}
+	return nodes
+}
+
+// unquote returns the content of a quoted string with \" and \\ unescaped, or
+// s itself if it is not quoted.
+func unquote(s []byte) string {
+	if s[0] != '"' {
+		return string(s)
+	}
+	s = s[1 : len(s)-1]
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+var parsers = sync.Pool{New: func() interface{} {
+	return parse.New(text)
+}}
+
+// Parse parses an OGDL text into a tree, whose root has an empty value and the
+// nodes of the top level lines as its children. An error is returned as a
+// *SyntaxError.
+func Parse(data []byte) (*Node, error) {
+	return parseFrom(NewBytesScanner(data), false)
+}
+
+// ParseReader parses an OGDL text read from r, see Parse. An error of r is
+// returned as is.
+func ParseReader(r io.Reader) (*Node, error) {
+	return parseFrom(NewScanner(r), true)
+}
+
+// parseFrom parses the tokens from s, the values of the tokens are copied if
+// they are overwritten by the scanner, because a token is evaluated by its
+// action only after the next token is scanned.
+func parseFrom(s *Scanner, copyValues bool) (*Node, error) {
+	p := parsers.Get().(*parse.Parser)
+	defer parsers.Put(p)
+	p.Reset()
+	for s.Scan() {
+		t := s.Token()
+		if copyValues {
+			t.Value = append([]byte(nil), t.Value...)
+		}
+		if !p.Parse(&parse.Token{ID: int(t.Kind), Value: t.Value, Pos: t.Offset}, tokenTable[t.Kind]) {
+			break
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if p.Error() == nil {
+		t := s.Token()
+		p.Parse(&parse.Token{Pos: t.Offset}, parse.EOF)
+	}
+	if errs := p.Errors(); len(errs) > 0 {
+		return nil, s.parseError(errs[0])
+	}
+	return p.Value().(*Node), nil
+}
+
+func (s *Scanner) parseError(e *parse.Error) *SyntaxError {
+	found := e.Term.Name()
+	if e.Term == str {
+		found = "string " + strconv.Quote(string(e.Token.Value))
+	}
+	expected := make([]string, len(e.Expected))
+	for i, r := range e.Expected {
+		expected[i] = r.Name()
+	}
+	return s.syntaxError(e.Token.Pos, "unexpected %s, expected %s", found, strings.Join(expected, " or "))
+}
@@ -0,0 +1,262 @@
+// Package ogdl scans and parses OGDL, a textual format of trees, in which a
+// line is a chain of nodes, each being the child of the previous one, and an
+// indented line is the child of the last node of the previous line. A group of
+// nodes in braces is at the same level as the node following it, so the flow
+// syntax of github.com/ogdl/flow is also accepted.
+//
+//	a b c       a has a child b, which has a child c
+//	d, e        d and e are siblings
+//	  f         f is a child of e
+//	g {h, i,} j h, i and j are children of g
+//	"k l" // m  a quoted string and a comment
+package ogdl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"h12.io/gombi/scan"
+)
+
+// Kind is the kind of a token.
+type Kind int
+
+const (
+	EOF Kind = iota
+	Illegal
+	String
+	Comma      // ,
+	LeftBrace  // {
+	RightBrace // }
+	Newline    // the end of a line
+	Indent     // the start of a more indented line
+	Dedent     // the end of an indented block
+	comment
+	lineBreakKind
+	space
+	kindCount
+)
+
+var kindNames = []string{
+	EOF:           "EOF",
+	Illegal:       "Illegal",
+	String:        "string",
+	Comma:         ",",
+	LeftBrace:     "{",
+	RightBrace:    "}",
+	Newline:       "newline",
+	Indent:        "indent",
+	Dedent:        "dedent",
+	comment:       "comment",
+	lineBreakKind: "line break",
+	space:         "space",
+}
+
+func (k Kind) String() string {
+	if k >= 0 && k < kindCount {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+var matcher = spec()
+
+// Token is a token scanned by Scanner.
+type Token struct {
+	Kind   Kind
+	Offset int    // the byte offset in the input
+	Value  []byte // empty for Newline, Indent and Dedent
+}
+
+// SyntaxError is an invalid OGDL text at a position, the line and column are
+// 1-based and the column is in bytes.
+type SyntaxError struct {
+	Offset int
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// Scanner is a streaming scanner of OGDL tokens. Spaces and comments are
+// skipped, and the line breaks outside braces are converted into a Newline at
+// the end of each nonblank line, followed by an Indent or Dedents when the
+// indentation of the next nonblank line changes. The Dedents of the indented
+// blocks left open are emitted at EOF.
+type Scanner struct {
+	s       scan.Scanner
+	lines   *scan.Lines
+	err     error
+	tok     Token
+	pending []Token  // the tokens to return before scanning further
+	indents []string // the indentations of the base and the open blocks
+	indent  string   // the indentation of the current line
+	start   bool     // at the start of a line
+	first   bool     // no token has been returned
+	braces  int      // the depth of the braces
+}
+
+// NewScanner returns a scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	s := newScanner()
+	s.s.SetReader(r)
+	return s
+}
+
+// NewBytesScanner returns a scanner of the whole input src.
+func NewBytesScanner(src []byte) *Scanner {
+	s := newScanner()
+	s.s.SetSource(src)
+	return s
+}
+
+func newScanner() *Scanner {
+	s := &Scanner{s: scan.Scanner{Matcher: matcher}, lines: scan.NewLines(nil), start: true, first: true}
+	s.s.SetLines(s.lines)
+	return s
+}
+
+// Scan advances to the next token, and returns false at EOF or when an error
+// occurs, see Err.
+func (s *Scanner) Scan() bool {
+	if len(s.pending) == 0 && s.err == nil {
+		s.scan()
+	}
+	if len(s.pending) == 0 {
+		return false
+	}
+	s.tok, s.pending = s.pending[0], s.pending[1:]
+	return s.tok.Kind != EOF
+}
+
+// scan appends the tokens up to and including the next one from the input to
+// the pending tokens, or sets the error.
+func (s *Scanner) scan() {
+	for s.s.Scan() {
+		t := s.s.Token()
+		switch Kind(t.ID) {
+		case comment:
+			continue
+		case space:
+			if s.first {
+				s.indent = string(s.s.Bytes())
+			}
+			continue
+		case lineBreakKind:
+			if s.braces == 0 {
+				s.start = true
+				s.indent = string(bytes.TrimLeft(s.s.Bytes(), "\r\n"))
+			}
+			continue
+		case Illegal:
+			s.err = s.illegal(t)
+			return
+		case EOF:
+			s.endLines(t.Lo)
+			s.pending = append(s.pending, Token{Kind: EOF, Offset: t.Lo})
+			return
+		case LeftBrace:
+			s.braces++
+		case RightBrace:
+			if s.braces > 0 {
+				s.braces--
+			}
+		}
+		if s.start {
+			if !s.startLine(t.Lo) {
+				return
+			}
+		}
+		s.pending = append(s.pending, Token{Kind: Kind(t.ID), Offset: t.Lo, Value: s.s.Bytes()})
+		return
+	}
+	s.err = s.s.Error()
+}
+
+// startLine appends the tokens before the first token of a line at off.
+func (s *Scanner) startLine(off int) bool {
+	if s.first {
+		// the indentation of the first line is the base of the others
+		s.indents = append(s.indents[:0], s.indent)
+		s.start, s.first = false, false
+		return true
+	}
+	s.pending = append(s.pending, Token{Kind: Newline, Offset: off})
+	s.start = false
+	top := func() string { return s.indents[len(s.indents)-1] }
+	switch cur := top(); {
+	case s.indent == cur:
+	case len(s.indent) > len(cur) && strings.HasPrefix(s.indent, cur):
+		s.indents = append(s.indents, s.indent)
+		s.pending = append(s.pending, Token{Kind: Indent, Offset: off})
+	default:
+		for len(s.indents) > 1 && len(top()) > len(s.indent) {
+			s.indents = s.indents[:len(s.indents)-1]
+			s.pending = append(s.pending, Token{Kind: Dedent, Offset: off})
+		}
+		if s.indent != top() {
+			s.err = s.syntaxError(off, "inconsistent indentation")
+			s.pending = s.pending[:0]
+			return false
+		}
+	}
+	return true
+}
+
+// endLines appends the Newline of the last line and the Dedents of the open
+// blocks at EOF.
+func (s *Scanner) endLines(off int) {
+	if s.first {
+		return
+	}
+	s.pending = append(s.pending, Token{Kind: Newline, Offset: off})
+	for range s.indents[1:] {
+		s.pending = append(s.pending, Token{Kind: Dedent, Offset: off})
+	}
+	s.indents = s.indents[:1]
+}
+
+// Token returns the current token.
+func (s *Scanner) Token() Token {
+	return s.tok
+}
+
+// Err returns the first error met by Scan, either a *SyntaxError or an error
+// of the reader, or nil at EOF.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// syntaxError returns a *SyntaxError at the offset off, which must have been
+// scanned.
+func (s *Scanner) syntaxError(off int, format string, args ...interface{}) *SyntaxError {
+	p := s.lines.Position(off)
+	return &SyntaxError{Offset: off, Line: p.Line, Column: p.Column, Msg: fmt.Sprintf(format, args...)}
+}
+
+// illegal returns the error of an illegal token, at the position where the
+// matcher stops.
+func (s *Scanner) illegal(t *scan.Token) *SyntaxError {
+	text := s.s.Bytes()
+	c := s.quoteAt(t.Hi)
+	switch {
+	case len(text) == 0:
+		return s.syntaxError(t.Lo, "invalid character %s", c)
+	case c == "EOF":
+		return s.syntaxError(t.Hi, "unexpected EOF in quoted string")
+	}
+	return s.syntaxError(t.Hi, "invalid character %s in quoted string", c)
+}
+
+// quoteAt returns the quoted byte at off, or EOF.
+func (s *Scanner) quoteAt(off int) string {
+	if b := s.s.Slice(off, off+1); len(b) > 0 {
+		return fmt.Sprintf("%q", b[0])
+	}
+	return "EOF"
+}
@@ -0,0 +1,42 @@
+package ogdl
+
+import (
+	"unicode/utf8"
+
+	"h12.io/gombi/scan"
+)
+
+// spec returns the matcher of the raw OGDL tokens. A line break is matched
+// together with the indentation of the next line, and the Scanner converts it
+// into Newline, Indent and Dedent.
+func spec() *scan.Matcher {
+	var (
+		c   = scan.Char
+		b   = scan.Between
+		s   = scan.Str
+		or  = scan.Or
+		con = scan.Con
+
+		nonctrl   = or(b(0x20, 0x7e), b(0xa0, utf8.MaxRune).Exclude(b(0xd800, 0xdfff)))
+		indent    = c("\t ")
+		lineBreak = or(c("\n\r"), s("\r\n"))
+		inline    = or(nonctrl, c("\t"))
+		delim     = c(`,{}`)
+
+		inlineComment  = con(`//`, inline.Repeat())
+		quoted         = or(inline.Exclude(c(`"\`)), s(`\"`), s(`\\`))
+		quotedString   = con(`"`, quoted.Repeat(), `"`)
+		unquoted       = nonctrl.Exclude(delim, c(" "))
+		unquotedString = con(unquoted.Exclude(c(`"`)), unquoted.Repeat())
+	)
+	return scan.NewMatcher(int(EOF), int(Illegal), []scan.MID{
+		{inlineComment, int(comment)},
+		{`{`, int(LeftBrace)},
+		{`}`, int(RightBrace)},
+		{`,`, int(Comma)},
+		{quotedString, int(String)},
+		{unquotedString, int(String)},
+		{con(lineBreak, indent.Repeat()), int(lineBreakKind)},
+		{indent.AtLeast(1), int(space)},
+	})
+}
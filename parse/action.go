@@ -0,0 +1,193 @@
+package parse
+
+/*
+Semantic actions
+
+An action converts a derivation of a rule into a value from the values of its
+children, so that a parse result can be converted into a useful value without
+walking the Node tree by hand.
+
+The default value of a derivation without an action is:
+1. the *Token of a terminal.
+2. the value of the only child, e.g. the value of the chosen alternative of
+   Or(a, b), or nil if there is no child, e.g. the empty alternative of
+   r.Optional().
+3. a []interface{} of the values of the children otherwise.
+4. a []interface{} of the values of each r for the rules created by
+   r.ZeroOrMore(), r.Repeat() and r.AtLeast(1).
+
+The parser evaluates a derivation with an action once it is completed, and the
+children of the node are released after the next token is parsed, so that the
+tree below the nodes with actions is not kept in memory. A derivation is
+completed before it is known to be part of the result, so an action may also
+be called for a derivation that is later abandoned, and it should only compute
+a value from its arguments without side effects.
+
+The other derivations of an ambiguous node are packed after the first one is
+completed, so an ambiguous node takes the value of its first derivation, the
+same one walked by the methods of Node and by Unmarshal.
+
+The lists, the nodes directly containing a list and the nodes without actions
+are evaluated lazily by the parent or by Parser.Value and Node.Eval. The
+children are kept in the incremental mode, because the subtrees are reused
+after an edit, or if a Reject filter is used, because the filter needs the
+tokens of a derivation.
+*/
+
+// Action converts the values of the children of a derivation into the value of
+// the derivation.
+type Action func(values []interface{}) interface{}
+
+type list int
+
+const (
+	notList list = iota
+	oneOrMore
+	zeroOrMore
+)
+
+// Action sets the action of the alternatives of r. The only value passed to the
+// action of a terminal is its *Token.
+func (r *R) Action(f Action) *R {
+	for _, a := range r.Alts {
+		a.action = f
+	}
+	return r
+}
+
+// Eval returns the value of n evaluated by the actions, see Action.
+func (n *Node) Eval() interface{} {
+	return n.eval()
+}
+
+// Value returns the value of the parse result evaluated by the actions, or nil
+// if there is no result.
+func (p *Parser) Value() interface{} {
+	if len(p.results) == 0 {
+		return nil
+	}
+	return p.results[0].Eval()
+}
+
+func (n *Node) eval() interface{} {
+	if n == nil {
+		return nil
+	}
+	if n.evaluated {
+		return n.val
+	}
+	if n.alt.R.list != notList {
+		n.val = n.evalList()
+		n.evaluated = true
+		return n.val
+	}
+	n.val = n.evalAlt()
+	n.evaluated = true
+	return n.val
+}
+
+// evalAlt evaluates the children of n and applies the action of its
+// alternative.
+func (n *Node) evalAlt() interface{} {
+	var values []interface{}
+	if n.token != nil {
+		values = []interface{}{n.token}
+	} else {
+		values = make([]interface{}, len(n.values))
+		for i, c := range n.values {
+			values[i] = c.eval()
+		}
+	}
+	switch {
+	case n.alt.action != nil:
+		return n.alt.action(values)
+	case len(values) == 1:
+		return values[0]
+	case len(values) > 1:
+		return values
+	}
+	return nil
+}
+
+// evalList evaluates each repetition of r+ ::= r | r+ r or r* ::= r+ | ε.
+func (n *Node) evalList() []interface{} {
+	items := n.items()
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		values[i] = item.eval()
+	}
+	return values
+}
+
+// items returns the repetitions of a node of the rule created by ZeroOrMore,
+// Repeat() or AtLeast(1), or n itself for any other rule. The left recursion
+// is walked iteratively, so that a list is evaluated in linear time.
+func (n *Node) items() (nodes []*Node) {
+	switch n.alt.R.list {
+	case notList:
+		return []*Node{n}
+	case zeroOrMore:
+		nodes = []*Node{}
+		if len(n.values) == 0 {
+			return
+		}
+		n = n.values[0]
+	}
+	n.EachItem(func(item *Node) {
+		nodes = append(nodes, item)
+	})
+	return
+}
+
+// evalCompleted evaluates the node of the completed state s if its alternative
+// has an action. The lists, and the nodes directly containing a list, are
+// evaluated lazily by the parent, because evaluating each prefix of a list
+// takes quadratic time.
+func (ss *stateSet) evalCompleted(s *state) {
+	if !ss.eval || s.Alt.action == nil || s.node.evaluated || s.Alt.R.list != notList || s.Alt.hasList() {
+		return
+	}
+	s.node.eval()
+	if s.node.token == nil {
+		ss.completed = append(ss.completed, s.node)
+	}
+}
+
+func (a *Alt) hasList() bool {
+	for _, r := range a.Rules {
+		if r.list != notList {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseCompleted releases the children of the nodes evaluated within the
+// set. It is safe because a node is only modified within the set where it is
+// completed.
+func (ss *stateSet) releaseCompleted() {
+	for _, n := range ss.completed {
+		n.values = nil
+		n.packed = nil
+	}
+	ss.completed = nil
+}
+
+// released returns true if the children of n have been released after it was
+// evaluated.
+func (n *Node) released() bool {
+	return n.evaluated && n.token == nil && n.values == nil && len(n.alt.Rules) > 0
+}
+
+// hasActions returns whether any action is attached to the rules reachable from
+// r, and whether the children of the evaluated nodes can be released.
+func hasActions(r *R) (eval, release bool) {
+	r.eachReachable(make(map[*R]bool), func(r *R) {
+		for _, a := range r.Alts {
+			if a.action != nil {
+				eval = true
+			}
+		}
+	})
+	return eval, eval && !hasReject(r)
+}
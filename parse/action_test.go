@@ -0,0 +1,171 @@
+package parse
+
+import (
+	"strconv"
+
+	"h12.io/gspec"
+)
+
+var _ = gspec.Add(func(s gspec.S) {
+	describe, testcase, given := gspec.Alias3("describe", "testcase:", "given", s)
+
+	describe("the actions", func() {
+
+		given("a calculator grammar", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				N    = Term("N")
+				Plus = Term(`+`)
+				Mult = Term(`*`)
+				E    = NewRule().As("E")
+				_    = E.Define(Or(
+					N.Action(func(v []interface{}) interface{} {
+						n, _ := strconv.Atoi(string(v[0].(*Token).Value))
+						return n
+					}),
+					Con(E, Plus, E).Left().Prec(1).Action(func(v []interface{}) interface{} {
+						return v[0].(int) + v[2].(int)
+					}),
+					Con(E, Mult, E).Left().Prec(2).Action(func(v []interface{}) interface{} {
+						return v[0].(int) * v[2].(int)
+					}),
+				))
+				P = Con(E, EOF).As("P").Action(func(v []interface{}) interface{} {
+					return v[0]
+				})
+			)
+			P.InitTermSet()
+			testcase("precedence", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{
+					tok("1", N), tok("+", Plus), tok("2", N), tok("*", Mult), tok("3", N),
+				})
+				expect(p.Value()).Equal(7)
+				p = parseAll(P, TT{
+					tok("2", N), tok("*", Mult), tok("3", N), tok("+", Plus), tok("4", N),
+				})
+				expect(p.Value()).Equal(10)
+			})
+			testcase("the children are released", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{
+					tok("1", N), tok("+", Plus), tok("2", N),
+				})
+				expect(p.Value()).Equal(3)
+				expect(p.Results()[0].Child(0).ChildCount()).Equal(0)
+			})
+			testcase("the children are kept in the incremental mode", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := New(P)
+				p.SetIncremental(true)
+				for _, t := range (TT{tok("1", N), tok("+", Plus), tok("2", N), tok("", EOF)}) {
+					p.Parse(t.t, t.r)
+				}
+				expect(p.Value()).Equal(3)
+				expect(p.Results()[0].Child(0).ChildCount()).Equal(3)
+			})
+		})
+
+		given("a grammar with a list", func() {
+			b := NewBuilder()
+			Term, Con := b.Term, b.Con
+			var (
+				A = Term("A")
+				B = Term("B")
+				P = Con(A, B.ZeroOrMore(), EOF).As("P").Action(func(v []interface{}) interface{} {
+					var ss []string
+					for _, t := range v[1].([]interface{}) {
+						ss = append(ss, string(t.(*Token).Value))
+					}
+					return ss
+				})
+			)
+			P.InitTermSet()
+			testcase("zero", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{tok("a", A)})
+				expect(p.Value()).Equal([]string(nil))
+			})
+			testcase("three", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{tok("a", A), tok("1", B), tok("2", B), tok("3", B)})
+				expect(p.Value()).Equal([]string{"1", "2", "3"})
+			})
+		})
+
+		given("a rule directly containing a list", func() {
+			b := NewBuilder()
+			Term, Con := b.Term, b.Con
+			var (
+				calls int
+				A     = Term("A")
+				B     = Term("B")
+				L     = Con(A, B.ZeroOrMore()).As("L").Action(func(v []interface{}) interface{} {
+					calls++
+					return len(v[1].([]interface{}))
+				})
+				P = Con(L, EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("is evaluated once after the list", func() {
+				expect := gspec.Expect(s.FailNow)
+				calls = 0
+				p := parseAll(P, TT{tok("a", A), tok("1", B), tok("2", B), tok("3", B)})
+				expect(p.Value().([]interface{})[0]).Equal(3)
+				expect(calls).Equal(1)
+			})
+		})
+
+		given("a grammar with a separated list", func() {
+			b := NewBuilder()
+			Term, Con := b.Term, b.Con
+			var (
+				A     = Term("A")
+				Comma = Term(",")
+				P     = Con(A.SepBy(Comma), EOF).As("P").Action(func(v []interface{}) interface{} {
+					if v[0] == nil {
+						return []string(nil)
+					}
+					l := v[0].([]interface{})
+					ss := []string{string(l[0].(*Token).Value)}
+					for _, item := range l[1].([]interface{}) {
+						ss = append(ss, string(item.([]interface{})[1].(*Token).Value))
+					}
+					return ss
+				})
+			)
+			P.InitTermSet()
+			testcase("the first item is reduced into the list", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{tok("1", A)})
+				expect(p.Value()).Equal([]string{"1"})
+				p = parseAll(P, TT{tok("1", A), tok(",", Comma), tok("2", A), tok(",", Comma), tok("3", A)})
+				expect(p.Value()).Equal([]string{"1", "2", "3"})
+			})
+		})
+
+		given("a grammar without actions", func() {
+			b := NewBuilder()
+			Term, Con := b.Term, b.Con
+			var (
+				A = Term("A")
+				B = Term("B")
+				P = Con(Con(A, B).As("AB").Optional(), EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("default values", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{tok("a", A), tok("b", B)})
+				v := p.Value().([]interface{})
+				expect(len(v)).Equal(2)
+				ab := v[0].([]interface{})
+				expect(string(ab[1].(*Token).Value)).Equal("b")
+				expect(p.Results()[0].Child(0).ChildCount()).Equal(1)
+
+				p = parseAll(P, TT{})
+				expect(p.Value().([]interface{})[0]).Equal(nil)
+			})
+		})
+	})
+})
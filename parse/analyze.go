@@ -0,0 +1,439 @@
+package parse
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/*
+Grammar analysis
+
+Analyze checks a grammar before parsing, because most mistakes in a grammar do
+not cause an error but an empty result:
+1. Errors: a rule created by NewRule but never defined, a rule that derives no
+   string of terminals (e.g. a cycle without a base case), and a root rule
+   whose term sets are not initialized by InitTermSet.
+2. Warnings: a rule that is not reachable from the root, and the hints of
+   ambiguity, i.e. duplicate alternatives, more than one nullable alternative,
+   an alternative like E ::= E + E without precedence or associativity, and a
+   rule that derives itself.
+
+The nullable rules, the FIRST and FOLLOW sets and the left recursive rules are
+computed from the definitions of the rules independently of InitTermSet.
+*/
+
+// Issue is a problem of a rule found by Analyze.
+type Issue struct {
+	Rule    *R
+	Msg     string
+	Warning bool // a hint that does not prevent the rule from being parsed
+}
+
+func (i *Issue) Error() string {
+	return fmt.Sprintf("%s %s", i.Rule.Name(), i.Msg)
+}
+
+// IssueList is a list of *Issues in the order of the rules.
+type IssueList []*Issue
+
+func (l IssueList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no issues"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more issues)", l[0], len(l)-1)
+}
+
+// Err returns an error equivalent to this issue list.
+// If the list is empty, Err returns nil.
+func (l IssueList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+// Analysis holds the properties of the rules reachable from a root rule.
+type Analysis struct {
+	Root   *R
+	Rules  Rules     // the reachable rules in depth-first order
+	Issues IssueList // the issues in the order of Rules
+
+	nullable      map[*R]bool
+	productive    map[*R]bool
+	first         map[*R]map[*R]bool
+	follow        map[*R]map[*R]bool
+	leftCorners   map[*R]map[*R]bool
+	reachable     map[*R]bool
+	issuesOfRules map[*R]IssueList
+}
+
+// Validate returns the errors found by Analyze, or nil if there is none.
+func (r *R) Validate() error {
+	return Analyze(r).Errors().Err()
+}
+
+// Analyze analyzes the rules reachable from root. The optional rules are
+// checked to be reachable from root, e.g. all the named rules of a grammar.
+func Analyze(root *R, rules ...*R) *Analysis {
+	a := &Analysis{
+		Root:          root,
+		reachable:     make(map[*R]bool),
+		issuesOfRules: make(map[*R]IssueList),
+	}
+	root.eachReachable(a.reachable, func(r *R) {
+		a.Rules = append(a.Rules, r)
+	})
+	a.initNullable()
+	a.initProductive()
+	a.initFirst()
+	a.initFollow()
+	a.initLeftCorners()
+
+	for _, r := range rules {
+		if !a.reachable[r] {
+			a.addIssue(true, r, "is not reachable from %s", root.Name())
+		}
+	}
+	a.checkTermSets()
+	for _, r := range a.Rules {
+		a.checkRule(r)
+	}
+	for _, r := range a.Rules {
+		a.Issues = append(a.Issues, a.issuesOfRules[r]...)
+	}
+	for _, r := range rules {
+		if !a.reachable[r] {
+			a.Issues = append(a.Issues, a.issuesOfRules[r]...)
+		}
+	}
+	return a
+}
+
+func (a *Analysis) addIssue(warning bool, r *R, format string, args ...interface{}) {
+	a.issuesOfRules[r] = append(a.issuesOfRules[r], &Issue{
+		Rule:    r,
+		Msg:     fmt.Sprintf(format, args...),
+		Warning: warning,
+	})
+}
+
+// Errors returns the issues that are not warnings.
+func (a *Analysis) Errors() IssueList {
+	var l IssueList
+	for _, issue := range a.Issues {
+		if !issue.Warning {
+			l = append(l, issue)
+		}
+	}
+	return l
+}
+
+// Nullable returns true if r derives the empty string.
+func (a *Analysis) Nullable(r *R) bool {
+	return a.nullable[r]
+}
+
+// First returns the terminals that can begin a derivation of r, sorted by name.
+func (a *Analysis) First(r *R) Rules {
+	return sortRules(a.first[r])
+}
+
+// Follow returns the terminals that can immediately follow a derivation of r,
+// sorted by name.
+func (a *Analysis) Follow(r *R) Rules {
+	return sortRules(a.follow[r])
+}
+
+// LeftRecursive returns true if r derives a sequence beginning with r itself.
+func (a *Analysis) LeftRecursive(r *R) bool {
+	return a.leftCorners[r][r]
+}
+
+func (a *Analysis) initNullable() {
+	a.nullable = make(map[*R]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, r := range a.Rules {
+			if a.nullable[r] || r.isTerm() {
+				continue
+			}
+			for _, alt := range r.Alts {
+				if a.allNullable(alt.Rules) {
+					a.nullable[r] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (a *Analysis) allNullable(rs Rules) bool {
+	for _, r := range rs {
+		if !a.nullable[r] {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *Analysis) initProductive() {
+	a.productive = make(map[*R]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, r := range a.Rules {
+			if a.productive[r] {
+				continue
+			}
+			if r.isTerm() {
+				a.productive[r] = true
+				changed = true
+				continue
+			}
+			for _, alt := range r.Alts {
+				if a.allProductive(alt.Rules) {
+					a.productive[r] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (a *Analysis) allProductive(rs Rules) bool {
+	for _, r := range rs {
+		if !a.productive[r] {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *Analysis) initFirst() {
+	a.first = make(map[*R]map[*R]bool)
+	for _, r := range a.Rules {
+		a.first[r] = make(map[*R]bool)
+		if r.isTerm() {
+			a.first[r][r] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, r := range a.Rules {
+			for _, alt := range r.Alts {
+				if addAll(a.first[r], a.firstOf(alt.Rules)) {
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+// firstOf returns the terminals that can begin a derivation of the sequence rs.
+func (a *Analysis) firstOf(rs Rules) map[*R]bool {
+	m := make(map[*R]bool)
+	for _, r := range rs {
+		addAll(m, a.first[r])
+		if !a.nullable[r] {
+			break
+		}
+	}
+	return m
+}
+
+func (a *Analysis) initFollow() {
+	a.follow = make(map[*R]map[*R]bool)
+	for _, r := range a.Rules {
+		a.follow[r] = make(map[*R]bool)
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, r := range a.Rules {
+			for _, alt := range r.Alts {
+				for i, c := range alt.Rules {
+					rest := alt.Rules[i+1:]
+					if addAll(a.follow[c], a.firstOf(rest)) {
+						changed = true
+					}
+					if a.allNullable(rest) && addAll(a.follow[c], a.follow[r]) {
+						changed = true
+					}
+				}
+			}
+		}
+	}
+}
+
+// initLeftCorners computes the rules that can begin a derivation of each rule.
+func (a *Analysis) initLeftCorners() {
+	a.leftCorners = make(map[*R]map[*R]bool)
+	for _, r := range a.Rules {
+		m := make(map[*R]bool)
+		for _, alt := range r.Alts {
+			for _, c := range alt.Rules {
+				m[c] = true
+				if !a.nullable[c] {
+					break
+				}
+			}
+		}
+		a.leftCorners[r] = m
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, r := range a.Rules {
+			for c := range a.leftCorners[r] {
+				if addAll(a.leftCorners[r], a.leftCorners[c]) {
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+// derivesItself returns true if r derives r itself, i.e. r ::= α r β where both
+// α and β are nullable, so that a derivation of r can be infinitely nested.
+func (a *Analysis) derivesItself(r *R) bool {
+	visited := make(map[*R]bool)
+	var visit func(*R) bool
+	visit = func(x *R) bool {
+		for _, alt := range x.Alts {
+			for i, c := range alt.Rules {
+				if !a.allNullable(alt.Rules[:i]) || !a.allNullable(alt.Rules[i+1:]) {
+					continue
+				}
+				if c == r {
+					return true
+				}
+				if !visited[c] {
+					visited[c] = true
+					if visit(c) {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+	return visit(r)
+}
+
+// checkTermSets reports an error if the term sets or the nullable
+// alternatives do not match the analysis, i.e. InitTermSet is not called.
+func (a *Analysis) checkTermSets() {
+	for _, r := range a.Rules {
+		if r.isTerm() {
+			continue
+		}
+		if (r.nullAlt != nil) != a.nullable[r] {
+			a.addIssue(false, a.Root, "is not initialized by InitTermSet")
+			return
+		}
+		for _, alt := range r.Alts {
+			first := a.firstOf(alt.Rules)
+			if len(first) != len(alt.termSet) {
+				a.addIssue(false, a.Root, "is not initialized by InitTermSet")
+				return
+			}
+			for t := range alt.termSet {
+				if !first[t.R] {
+					a.addIssue(false, a.Root, "is not initialized by InitTermSet")
+					return
+				}
+			}
+		}
+	}
+}
+
+func (a *Analysis) checkRule(r *R) {
+	if r.isTerm() {
+		return
+	}
+	if len(r.Alts) == 0 {
+		a.addIssue(false, r, "is not defined")
+		return
+	}
+	if !a.productive[r] {
+		a.addIssue(false, r, "derives no string of terminals")
+	}
+	if a.derivesItself(r) {
+		a.addIssue(true, r, "derives itself and is ambiguous")
+	}
+	nullAlts := 0
+	for i, alt := range r.Alts {
+		if a.allNullable(alt.Rules) {
+			nullAlts++
+		}
+		for _, prev := range r.Alts[:i] {
+			if equalRules(prev.Rules, alt.Rules) {
+				a.addIssue(true, r, "has duplicate alternatives %s", alt)
+				break
+			}
+		}
+		if n := len(alt.Rules); n > 1 && alt.Rules[0] == r && alt.Rules[n-1] == r &&
+			(alt.filter == nil || alt.filter.prec == 0 && alt.filter.assoc == noAssoc) {
+			a.addIssue(true, r, "has alternative %s without precedence or associativity", alt)
+		}
+	}
+	if nullAlts > 1 {
+		a.addIssue(true, r, "has %d nullable alternatives", nullAlts)
+	}
+}
+
+// String returns the nullable rules, FIRST and FOLLOW sets of the named rules.
+func (a *Analysis) String() string {
+	var b strings.Builder
+	for _, r := range a.Rules {
+		if r.isTerm() || r.name == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "%s", r.Name())
+		if a.nullable[r] {
+			b.WriteString(" nullable")
+		}
+		if a.LeftRecursive(r) {
+			b.WriteString(" left-recursive")
+		}
+		fmt.Fprintf(&b, "\n\tFIRST: %s\n\tFOLLOW: %s\n", a.First(r).toString(" "), a.Follow(r).toString(" "))
+	}
+	return b.String()
+}
+
+func equalRules(x, y Rules) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// addAll adds all the rules of src to dst and returns true if dst is changed.
+func addAll(dst, src map[*R]bool) (changed bool) {
+	for r := range src {
+		if !dst[r] {
+			dst[r] = true
+			changed = true
+		}
+	}
+	return
+}
+
+func sortRules(m map[*R]bool) Rules {
+	rs := make(Rules, 0, len(m))
+	for r := range m {
+		rs = append(rs, r)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return strings.Compare(rs[i].Name(), rs[j].Name()) < 0
+	})
+	return rs
+}
@@ -0,0 +1,118 @@
+package parse
+
+import (
+	"h12.io/gspec"
+)
+
+var _ = gspec.Add(func(s gspec.S) {
+	describe, testcase, given := gspec.Alias3("describe", "testcase:", "given", s)
+
+	describe("the analyzer", func() {
+
+		given("simple arithmetic grammar", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				T    = Term("T")
+				Plus = Term(`+`)
+				Mult = Term(`*`)
+				M    = NewRule().As("M")
+				_    = M.Define(Or(
+					T,
+					Con(M, Mult, T),
+				))
+				S = NewRule().As("S")
+				_ = S.Define(Or(
+					Con(S, Plus, M),
+					M,
+				))
+				O = Con(Plus, T).Optional().As("O")
+				P = Con(S, O, EOF).As("P")
+			)
+			testcase("InitTermSet is not called", func() {
+				expect := gspec.Expect(s.FailNow)
+				err := NewRule().Ref(P).Validate()
+				expect(err).NotEqual(nil)
+				expect(err.Error()).Equal("P is not initialized by InitTermSet")
+			})
+			testcase("FIRST, FOLLOW and nullable", func() {
+				expect := gspec.Expect(s.FailNow)
+				P.InitTermSet()
+				a := Analyze(P, S, M, T)
+				expect(P.Validate()).Equal(nil)
+				expect(len(a.Issues)).Equal(0)
+				expect(a.First(S).toString(" ")).Equal("T")
+				expect(a.Follow(M).toString(" ")).Equal("* + EOF")
+				expect(a.Follow(T).toString(" ")).Equal("* + EOF")
+				expect(a.Nullable(O)).Equal(true)
+				expect(a.Nullable(S)).Equal(false)
+				expect(a.LeftRecursive(S)).Equal(true)
+				expect(a.LeftRecursive(P)).Equal(false)
+				expect(a.String()).Equal(gspec.Unindent(`
+					P
+						FIRST: T
+						FOLLOW: 
+					S left-recursive
+						FIRST: T
+						FOLLOW: + EOF
+					M left-recursive
+						FIRST: T
+						FOLLOW: * + EOF
+					O nullable
+						FIRST: +
+						FOLLOW: EOF`) + "\n")
+			})
+		})
+
+		given("a grammar with mistakes", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				T         = Term("T")
+				Plus      = Term(`+`)
+				Undefined = NewRule().As("Undefined")
+				Loop      = NewRule().As("Loop")
+				_         = Loop.Define(Con(T, Loop))
+				E         = NewRule().As("E")
+				_         = E.Define(Or(
+					Con(E, Plus, E),
+					Con(E, Plus, E),
+					T,
+				))
+				N = NewRule().As("N")
+				_ = N.Define(Or(Empty, T.Optional(), N))
+				U = Con(T, T).As("U")
+				P = Con(Or(Undefined, Loop, E, N), EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("issues", func() {
+				expect := gspec.Expect(s.FailNow)
+				a := Analyze(P, U)
+				issues := make([]string, len(a.Issues))
+				for i, issue := range a.Issues {
+					issues[i] = issue.Error()
+				}
+				expect(issues).Equal([]string{
+					"Undefined is not defined",
+					"Loop derives no string of terminals",
+					"E has alternative E + E without precedence or associativity",
+					"E has duplicate alternatives E + E",
+					"E has alternative E + E without precedence or associativity",
+					"N derives itself and is ambiguous",
+					"N has 3 nullable alternatives",
+					"U is not reachable from P",
+				})
+				expect(len(a.Errors())).Equal(2)
+				expect(P.Validate().Error()).Equal("Undefined is not defined (and 1 more issues)")
+			})
+			testcase("precedence and associativity", func() {
+				expect := gspec.Expect(s.FailNow)
+				E.Left()
+				a := Analyze(P)
+				for _, issue := range a.Issues {
+					expect(issue.Msg).NotEqual("has alternative E + E without precedence or associativity")
+				}
+			})
+		})
+	})
+})
@@ -32,6 +32,9 @@ func (rs Rules) toString(sep string) string {
 }
 
 func (a Alt) String() string {
+	if len(a.Rules) == 0 && !a.R.isTerm() {
+		return "ε"
+	}
 	return a.Rules.toString(" ")
 }
 
@@ -135,17 +138,35 @@ func (n *Node) String() string {
 		if s.Rule().isTerm() {
 			output += fmt.Sprintf("%s%s ::= %v\n", identStr, s.alt.R.name, escape(string(s.token.Value)))
 		} else {
-			output += fmt.Sprintf("%s%s ::= %s\n", identStr, s.alt.R.name, s.alt.String())
+			output += fmt.Sprintf("%s%s ::= %s\n", identStr, s.alt.R.Name(), s.alt.String())
 		}
 	})
 	return output
 }
 
 func parens(s string) string {
-	if strings.ContainsAny(s, " |") {
-		if !strings.HasPrefix(s, "(") {
-			return "(" + s + ")"
-		}
+	if strings.ContainsAny(s, " |") && !enclosed(s) {
+		return "(" + s + ")"
 	}
 	return s
 }
+
+// enclosed returns true if s is enclosed by a pair of parentheses.
+func enclosed(s string) bool {
+	if !strings.HasPrefix(s, "(") {
+		return false
+	}
+	depth := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i == len(s)-1
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+package ebnf
+
+import (
+	"strconv"
+	"strings"
+)
+
+/*
+The ABNF of RFC 5234, simplified as the comments and the line breaks are
+skipped by the scanner:
+
+rulelist      = 1*rule
+rule          = rulename defined-as elements
+defined-as    = "=" / "=/"
+elements      = alternation
+alternation   = concatenation *("/" concatenation)
+concatenation = repetition *repetition
+repetition    = [repeat] element
+element       = rulename / group / option / char-val / num-val / prose-val
+group         = "(" alternation ")"
+option        = "[" alternation "]"
+
+A rule ends where the next rule name starts at the beginning of a line. A
+char-val is a literal terminal, a num-val is a literal terminal if it is a
+single value or a concatenation, otherwise a range like %x41-5A is a terminal
+whose key is its text, and so is a prose-val like <text>.
+*/
+
+type abnfParser struct {
+	parser
+}
+
+func (p *abnfParser) parse() {
+	for p.tok().id != tEOF {
+		p.parseRule()
+	}
+}
+
+func (p *abnfParser) parseRule() {
+	t := p.tok()
+	if t.id != tName {
+		p.errorExpected("rule name")
+		p.skipRule()
+		return
+	}
+	p.next()
+	incremental := false
+	switch p.tok().id {
+	case tDefine:
+	case tIncDefine:
+		incremental = true
+	default:
+		p.errorExpected("'=' or '=/'")
+		p.skipRule()
+		return
+	}
+	p.next()
+	errCount := p.errs.Len()
+	x := p.parseAlternation()
+	if !p.atRuleEnd() {
+		if p.errs.Len() == errCount {
+			p.errorExpected("new rule")
+		}
+		p.skipRule()
+		return
+	}
+	p.define(t.pos, t.lit, x, incremental)
+}
+
+// atRuleEnd returns true at EOF or a name at the beginning of a line.
+func (p *abnfParser) atRuleEnd() bool {
+	t := p.tok()
+	return t.id == tEOF || t.id == tName && (t.pos == 0 || p.src[t.pos-1] == '\n')
+}
+
+// skipRule skips tokens after a syntax error until the end of the current
+// rule.
+func (p *abnfParser) skipRule() {
+	p.next()
+	for !p.atRuleEnd() {
+		p.next()
+	}
+}
+
+func (p *abnfParser) parseAlternation() expr {
+	var alts alternative
+	for {
+		alts = append(alts, p.parseConcatenation())
+		if p.tok().id != tSlash {
+			break
+		}
+		p.next()
+	}
+	if len(alts) == 1 {
+		return alts[0]
+	}
+	return alts
+}
+
+func (p *abnfParser) parseConcatenation() expr {
+	var seq sequence
+	for !p.atRuleEnd() {
+		x := p.parseRepetition()
+		if x == nil {
+			break
+		}
+		seq = append(seq, x)
+	}
+	switch len(seq) {
+	case 0:
+		p.errorExpected("element")
+		return nil
+	case 1:
+		return seq[0]
+	}
+	return seq
+}
+
+// parseRepetition returns nil if there is no repetition.
+func (p *abnfParser) parseRepetition() expr {
+	t := p.tok()
+	if t.id != tRepeat {
+		return p.parseElement()
+	}
+	p.next()
+	min, max := 0, -1
+	if i := strings.IndexByte(t.lit, '*'); i < 0 {
+		min = p.atoi(t.pos, t.lit)
+		max = min
+	} else {
+		if i > 0 {
+			min = p.atoi(t.pos, t.lit[:i])
+		}
+		if i < len(t.lit)-1 {
+			max = p.atoi(t.pos, t.lit[i+1:])
+		}
+	}
+	if max >= 0 && min > max {
+		p.errorf(t.pos, "invalid repeat %s", t.lit)
+	}
+	x := p.parseElement()
+	if x == nil {
+		p.errorExpected("element")
+	}
+	return repetition{x, min, max}
+}
+
+func (p *abnfParser) atoi(pos int, s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		p.errorf(pos, "invalid repeat %s", s)
+	}
+	return n
+}
+
+// parseElement returns nil if there is no element.
+func (p *abnfParser) parseElement() (x expr) {
+	t := p.tok()
+	switch t.id {
+	case tName:
+		p.next()
+		return name{t.pos, t.lit}
+	case tString:
+		p.next()
+		return literal{t.pos, t.lit[strings.IndexByte(t.lit, '"')+1 : len(t.lit)-1]}
+	case tNumVal:
+		p.next()
+		return literal{t.pos, p.numVal(t)}
+	case tProse:
+		p.next()
+		return literal{t.pos, t.lit}
+	case tLParen:
+		p.next()
+		x = p.parseAlternation()
+		p.expect(tRParen)
+		return x
+	case tLBrack:
+		p.next()
+		x = repetition{p.parseAlternation(), 0, 1}
+		p.expect(tRBrack)
+		return x
+	}
+	return nil
+}
+
+// numVal returns the value of a num-val, or its text if it is a range.
+func (p *abnfParser) numVal(t item) string {
+	if strings.Contains(t.lit, "-") {
+		return t.lit
+	}
+	base := 10
+	switch t.lit[1] {
+	case 'b', 'B':
+		base = 2
+	case 'x', 'X':
+		base = 16
+	}
+	var w strings.Builder
+	for _, s := range strings.Split(t.lit[2:], ".") {
+		n, err := strconv.ParseUint(s, base, 32)
+		if err != nil {
+			p.errorf(t.pos, "invalid numeric value %s", t.lit)
+		}
+		w.WriteRune(rune(n))
+	}
+	return w.String()
+}
@@ -0,0 +1,134 @@
+package ebnf
+
+import (
+	"strconv"
+)
+
+/*
+The EBNF of the Go spec, described in itself:
+
+Production  = production_name "=" [ Expression ] "." .
+Expression  = Alternative { "|" Alternative } .
+Alternative = Term { Term } .
+Term        = production_name | token [ "…" token ] | Group | Option | Repetition .
+Group       = "(" Expression ")" .
+Option      = "[" Expression "]" .
+Repetition  = "{" Expression "}" .
+
+Character ranges (token … token) are lexical and not supported.
+*/
+
+type ebnfParser struct {
+	parser
+}
+
+func (p *ebnfParser) parse() {
+	for p.tok().id != tEOF {
+		p.parseProduction()
+	}
+}
+
+func (p *ebnfParser) parseProduction() {
+	t := p.tok()
+	if t.id != tName {
+		p.errorExpected("production name")
+		p.skipProduction()
+		return
+	}
+	p.next()
+	p.expect(tDefine)
+	var x expr
+	errCount := p.errs.Len()
+	if p.tok().id != tPeriod {
+		x = p.parseExpression()
+	}
+	if p.tok().id != tPeriod {
+		if p.errs.Len() == errCount {
+			p.errorExpected(tokenNames[tPeriod])
+		}
+		p.skipProduction()
+		return
+	}
+	p.next()
+	p.define(t.pos, t.lit, x, false)
+}
+
+// skipProduction skips tokens after a syntax error until the end of the
+// current production.
+func (p *ebnfParser) skipProduction() {
+	for p.tok().id != tEOF {
+		id := p.tok().id
+		p.next()
+		if id == tPeriod {
+			return
+		}
+	}
+}
+
+func (p *ebnfParser) parseExpression() expr {
+	var alts alternative
+	for {
+		alts = append(alts, p.parseAlternative())
+		if p.tok().id != tBar {
+			break
+		}
+		p.next()
+	}
+	if len(alts) == 1 {
+		return alts[0]
+	}
+	return alts
+}
+
+func (p *ebnfParser) parseAlternative() expr {
+	var seq sequence
+	for x := p.parseTerm(); x != nil; x = p.parseTerm() {
+		seq = append(seq, x)
+	}
+	switch len(seq) {
+	case 0:
+		p.errorExpected("expression")
+		return nil
+	case 1:
+		return seq[0]
+	}
+	return seq
+}
+
+// parseTerm returns nil if there is no term.
+func (p *ebnfParser) parseTerm() (x expr) {
+	t := p.tok()
+	switch t.id {
+	case tName:
+		p.next()
+		return name{t.pos, t.lit}
+	case tString:
+		p.next()
+		value, err := strconv.Unquote(t.lit)
+		if err != nil {
+			p.errorf(t.pos, "invalid string %s: %v", t.lit, err)
+		}
+		if p.tok().id == tEllipsis {
+			p.errorf(p.tok().pos, "character ranges are not supported")
+			p.next()
+			p.expect(tString)
+		}
+		return literal{t.pos, value}
+	case tLParen:
+		p.next()
+		x = p.parseExpression()
+		p.expect(tRParen)
+		return x
+	case tLBrack:
+		p.next()
+		x = repetition{p.parseExpression(), 0, 1}
+		p.expect(tRBrack)
+		return x
+	case tLBrace:
+		p.next()
+		x = repetition{p.parseExpression(), 0, -1}
+		p.expect(tRBrace)
+		return x
+	}
+	return nil
+}
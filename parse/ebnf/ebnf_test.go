@@ -0,0 +1,182 @@
+package ebnf
+
+import (
+	"go/scanner"
+	"testing"
+
+	"h12.io/gombi/parse"
+	"h12.io/gspec"
+)
+
+const (
+	tokEOF = iota
+	tokNumber
+	tokPlus
+	tokMinus
+	tokMult
+	tokQuo
+	tokLParen
+	tokRParen
+)
+
+var arithTokens = map[string]int{
+	"EOF":    tokEOF,
+	"number": tokNumber,
+	"+":      tokPlus,
+	"-":      tokMinus,
+	"*":      tokMult,
+	"/":      tokQuo,
+	"(":      tokLParen,
+	")":      tokRParen,
+}
+
+type testToken struct {
+	id    int
+	value string
+}
+
+func parseTokens(g *Grammar, root *parse.R, toks []testToken) *parse.Parser {
+	p := parse.New(root)
+	for i, t := range append(toks, testToken{tokEOF, ""}) {
+		if !p.Parse(&parse.Token{ID: t.id, Value: []byte(t.value), Pos: i}, g.Term(t.id)) {
+			break
+		}
+	}
+	return p
+}
+
+func TestEBNF(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	g, err := Load("arith.ebnf", []byte(`
+Expr   = Term { ( "+" | "-" ) Term } .
+Term   = Factor { ( "*" | "/" ) Factor } .
+Factor = number | "(" Expr ")" .
+`), EBNF)
+	expect(err).Equal(nil)
+	expect(g.Names()).Equal([]string{"Expr", "Term", "Factor"})
+	root, err := g.Build("Expr", arithTokens)
+	expect(err).Equal(nil)
+	p := parseTokens(g, root, []testToken{
+		{tokNumber, "1"}, {tokPlus, "+"}, {tokNumber, "2"}, {tokMult, "*"}, {tokNumber, "3"},
+	})
+	expect(p.Error()).Equal(nil)
+	expect(len(p.Results())).Equal(1)
+	expect(p.Results()[0].String()).Equal(gspec.Unindent(`
+		Expr' ::= Expr EOF
+			Expr ::= Term ((+ | -) Term)*
+				Term ::= Factor ((* | /) Factor)*
+					Factor ::= number
+						number ::= 1
+					((* | /) Factor)* ::= ε
+				((+ | -) Term)* ::= ((+ | -) Term)+
+					((+ | -) Term)+ ::= ((+ | -) Term)
+						((+ | -) Term) ::= (+ | -) Term
+							(+ | -) ::= +
+								+ ::= +
+							Term ::= Factor ((* | /) Factor)*
+								Factor ::= number
+									number ::= 2
+								((* | /) Factor)* ::= ((* | /) Factor)+
+									((* | /) Factor)+ ::= ((* | /) Factor)
+										((* | /) Factor) ::= (* | /) Factor
+											(* | /) ::= *
+												* ::= *
+											Factor ::= number
+												number ::= 3
+			EOF ::= `) + "\n")
+}
+
+func TestABNF(t *testing.T) {
+	const (
+		tokToken = iota + 1
+		tokSlash
+		tokRWS
+		tokComment
+	)
+	expect := gspec.Expect(t.FailNow)
+	g, err := Load("ua.abnf", []byte(`
+; RFC 7231, section 5.5.3
+User-Agent = product *( RWS ( product / comment ) )
+product         = token ["/" product-version]
+product-version = token
+`), ABNF)
+	expect(err).Equal(nil)
+	root, err := g.Build("user-agent", map[string]int{
+		"EOF":     tokEOF,
+		"token":   tokToken,
+		"/":       tokSlash,
+		"RWS":     tokRWS,
+		"comment": tokComment,
+	})
+	expect(err).Equal(nil)
+	expect(g.Rule("PRODUCT").Name()).Equal("product")
+	p := parseTokens(g, root, []testToken{
+		{tokToken, "Mozilla"}, {tokSlash, "/"}, {tokToken, "5.0"},
+		{tokRWS, " "}, {tokComment, "(X11)"},
+		{tokRWS, " "}, {tokToken, "Gecko"},
+	})
+	expect(p.Error()).Equal(nil)
+	expect(len(p.Results())).Equal(1)
+	expect(p.Results()[0].Ambiguous()).Equal(false)
+}
+
+func TestABNFNumVal(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	g, err := Load("num.abnf", []byte(`
+crlf = %d13.10
+rule = crlf / %x41 / %x61-7A / <prose>
+rule =/ 2*3"x"
+`), ABNF)
+	expect(err).Equal(nil)
+	_, err = g.Build("rule", map[string]int{"\r\n": 1, "A": 2, "%x61-7A": 3, "<prose>": 4, "x": 5})
+	expect(err).Equal(nil)
+	expect(g.Rule("rule").String()).Equal(`rule ::= (crlf | A | %x61-7A | <prose> | (x x | x x x))`)
+}
+
+func TestErrors(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	for _, testcase := range []struct {
+		syntax Syntax
+		src    string
+		err    string
+	}{
+		{EBNF, "A = B\nB = \"b\" .", `a:2:3: expected '.', found '='`},
+		{EBNF, "A = \"a\" | .", `a:1:11: expected expression, found '.'`},
+		{EBNF, "A = \"a\" … \"z\" .", `a:1:9: character ranges are not supported`},
+		{EBNF, "A = \"a\" .\nA = \"b\" .", `a:2:1: A redeclared, previous declaration at a:1:1`},
+		{EBNF, "A = \"a\" # .", `a:1:9: expected '.', found illegal character "#"`},
+		{ABNF, "a = \"a\"\n  / )", `a:2:5: expected element, found ')'`},
+		{ABNF, "a =/ \"a\"", `a:1:1: a is not defined before =/`},
+	} {
+		_, err := Load("a", []byte(testcase.src), testcase.syntax)
+		expect(testcase.src, err).NotEqual(nil)
+		expect(testcase.src, err.Error()).Equal(testcase.err)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	g, err := Load("a", []byte(`
+A = B "x" "y" .
+B = number .
+C = "c" .
+`), EBNF)
+	expect(err).Equal(nil)
+	_, err = g.Build("A", map[string]int{"y": 1, "c": 2})
+	expect(err).NotEqual(nil)
+	expect(err.Error()).Equal(`a:2:7: terminal "x" is not bound to a token ID (and 2 more errors)`)
+	expect(errorStrings(err)).Equal([]string{
+		`a:2:7: terminal "x" is not bound to a token ID`,
+		`a:3:5: undefined: number`,
+		`a:4:1: C is defined but not used`,
+	})
+	_, err = g.Build("D", nil)
+	expect(err.Error()).Equal(`start rule D is not defined`)
+}
+
+func errorStrings(err error) (ss []string) {
+	for _, e := range err.(scanner.ErrorList) {
+		ss = append(ss, e.Error())
+	}
+	return
+}
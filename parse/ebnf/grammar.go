@@ -0,0 +1,338 @@
+/*
+Package ebnf loads grammars written in text as parse rules.
+
+Two notations are supported:
+ 1. EBNF, the notation used by the Go language specification:
+    Production = name "=" [ Expression ] "." .
+ 2. ABNF, defined in RFC 5234 (with the case-sensitive strings of RFC 7405).
+
+A grammar is loaded in two steps: Load parses the text, and Build binds the
+terminals to token IDs and converts the productions reachable from the start
+rule into parse.R. A terminal is either a literal string or a name that is not
+defined by any production, e.g. identifier in the Go spec, or the core rules
+like ALPHA and DIGIT in ABNF, which are supposed to be recognized by a scanner.
+*/
+package ebnf
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"strings"
+
+	"h12.io/gombi/parse"
+)
+
+// Syntax is the notation of a grammar text.
+type Syntax int
+
+const (
+	EBNF Syntax = iota // the EBNF of the Go spec
+	ABNF               // RFC 5234
+)
+
+// Grammar is a set of productions loaded from a text.
+type Grammar struct {
+	syntax      Syntax
+	file        *token.File
+	productions []*production
+	m           map[string]*production // indexed by key
+
+	rules map[string]*parse.R // built rules indexed by key
+	terms map[int]*parse.R    // terminals indexed by token ID
+}
+
+type production struct {
+	pos  int
+	name string
+	expr expr
+}
+
+type (
+	expr interface{}
+
+	alternative []expr
+	sequence    []expr
+	name        struct {
+		pos  int
+		name string
+	}
+	literal struct {
+		pos   int
+		value string // the key for binding a token ID
+	}
+	repetition struct {
+		x        expr
+		min, max int // max < 0 means unbounded
+	}
+)
+
+// Load parses a grammar text. filename is only used for error positions.
+func Load(filename string, src []byte, syntax Syntax) (*Grammar, error) {
+	g := &Grammar{
+		syntax: syntax,
+		file:   token.NewFileSet().AddFile(filename, -1, len(src)),
+		m:      make(map[string]*production),
+	}
+	g.file.SetLinesForContent(src)
+	var errs scanner.ErrorList
+	switch syntax {
+	case EBNF:
+		p := &ebnfParser{parser{g: g, errs: &errs, toks: tokenize(getEBNFMatcher(), src)}}
+		p.parse()
+	case ABNF:
+		p := &abnfParser{parser{g: g, errs: &errs, src: src, toks: tokenize(getABNFMatcher(), src)}}
+		p.parse()
+	default:
+		return nil, fmt.Errorf("unknown syntax %d", syntax)
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// key returns the key of a rule name, which is case-insensitive in ABNF.
+func (g *Grammar) key(name string) string {
+	if g.syntax == ABNF {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+func (g *Grammar) position(pos int) token.Position {
+	return g.file.Position(g.file.Pos(pos))
+}
+
+// Names returns the names of the productions in the order they are defined.
+func (g *Grammar) Names() []string {
+	names := make([]string, len(g.productions))
+	for i, p := range g.productions {
+		names[i] = p.name
+	}
+	return names
+}
+
+// Build converts the productions reachable from the start rule into parse
+// rules and returns the root rule, i.e. the start rule followed by parse.EOF,
+// ready for parse.New. tokens binds each terminal to a token ID, the key of a
+// literal terminal is its unquoted value, and the key of a named terminal is
+// its name, and the key "EOF" binds parse.EOF. An undefined rule, an unbound
+// terminal or an unused production is reported as an error.
+func (g *Grammar) Build(start string, tokens map[string]int) (*parse.R, error) {
+	b := &builder{
+		g:      g,
+		b:      parse.NewBuilder(),
+		tokens: tokens,
+	}
+	g.rules = make(map[string]*parse.R)
+	g.terms = make(map[int]*parse.R)
+	p, ok := g.m[g.key(start)]
+	if !ok {
+		return nil, fmt.Errorf("start rule %s is not defined", start)
+	}
+	startRule := b.rule(p)
+	for len(b.pending) > 0 {
+		p := b.pending[0]
+		b.pending = b.pending[1:]
+		b.define(g.rules[g.key(p.name)], p.expr)
+	}
+	for _, p := range g.productions {
+		if g.rules[g.key(p.name)] == nil {
+			b.errorf(p.pos, "%s is defined but not used", p.name)
+		}
+	}
+	b.errs.Sort()
+	if err := b.errs.Err(); err != nil {
+		return nil, err
+	}
+	root := b.b.Con(startRule, parse.EOF).As(p.name + "'")
+	root.InitTermSet()
+	if id, ok := tokens[parse.EOF.Name()]; ok {
+		g.terms[id] = parse.EOF
+	}
+	return root, nil
+}
+
+// Rule returns the rule built from the production of name, or nil if it is not
+// reachable from the start rule.
+func (g *Grammar) Rule(name string) *parse.R {
+	return g.rules[g.key(name)]
+}
+
+// Term returns the terminal rule bound to a token ID, to be passed to
+// parse.Parser.Parse together with the token.
+func (g *Grammar) Term(id int) *parse.R {
+	return g.terms[id]
+}
+
+type builder struct {
+	g       *Grammar
+	b       *parse.Builder
+	tokens  map[string]int
+	pending []*production
+	errs    scanner.ErrorList
+}
+
+func (b *builder) errorf(pos int, format string, args ...interface{}) {
+	b.errs.Add(b.g.position(pos), fmt.Sprintf(format, args...))
+}
+
+// rule returns the rule of a production, which is defined later to allow
+// recursion.
+func (b *builder) rule(p *production) *parse.R {
+	key := b.g.key(p.name)
+	if r, ok := b.g.rules[key]; ok {
+		return r
+	}
+	r := parse.NewRule().As(p.name)
+	b.g.rules[key] = r
+	b.pending = append(b.pending, p)
+	return r
+}
+
+// define defines r as the rule of x. Only a new rule built by Or or Con is
+// taken over by r, other rules like a production, a terminal, a repetition or
+// parse.Empty may be referenced elsewhere, so r refers to them instead.
+func (b *builder) define(r *parse.R, x expr) {
+	switch x := x.(type) {
+	case alternative:
+		if len(x) > 1 {
+			r.Define(b.expr(x))
+			return
+		}
+	case sequence:
+		if len(x) > 1 {
+			r.Define(b.expr(x))
+			return
+		}
+	}
+	r.Ref(b.expr(x))
+}
+
+func (b *builder) expr(x expr) *parse.R {
+	switch x := x.(type) {
+	case nil:
+		return parse.Empty
+	case alternative:
+		return b.b.Or(b.exprs(x)...)
+	case sequence:
+		if len(x) == 0 {
+			return parse.Empty
+		}
+		return b.b.Con(b.exprs(x)...)
+	case name:
+		if p, ok := b.g.m[b.g.key(x.name)]; ok {
+			return b.rule(p)
+		}
+		if _, ok := b.tokens[x.name]; !ok {
+			b.errorf(x.pos, "undefined: %s", x.name)
+		}
+		return b.term(x.pos, x.name)
+	case literal:
+		if _, ok := b.tokens[x.value]; !ok {
+			b.errorf(x.pos, "terminal %q is not bound to a token ID", x.value)
+		}
+		return b.term(x.pos, x.value)
+	case repetition:
+		r := b.expr(x.x)
+		switch {
+		case x.min == 0 && x.max == 1:
+			return r.Optional()
+		case x.max < 0 && x.min == 0:
+			return r.ZeroOrMore()
+		case x.max < 0:
+			return r.AtLeast(x.min)
+		case x.min == x.max:
+			return r.Repeat(x.min)
+		}
+		return r.Repeat(x.min, x.max)
+	}
+	panic(fmt.Sprintf("unexpected expression type %T", x))
+}
+
+func (b *builder) exprs(xs []expr) []interface{} {
+	rs := make([]interface{}, len(xs))
+	for i, x := range xs {
+		rs[i] = b.expr(x)
+	}
+	return rs
+}
+
+func (b *builder) term(pos int, key string) *parse.R {
+	r := b.b.Term(key)
+	id, ok := b.tokens[key]
+	if !ok {
+		return r
+	}
+	if t, ok := b.g.terms[id]; ok && t != r {
+		b.errorf(pos, "terminals %s and %s are bound to the same token ID %d", t.Name(), r.Name(), id)
+		return r
+	}
+	b.g.terms[id] = r
+	return r
+}
+
+// parser holds the state shared by the EBNF and ABNF parsers.
+type parser struct {
+	g    *Grammar
+	errs *scanner.ErrorList
+	src  []byte
+	toks []item
+	i    int
+}
+
+func (p *parser) tok() item {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() {
+	if p.i < len(p.toks)-1 {
+		p.i++
+	}
+}
+
+func (p *parser) errorf(pos int, format string, args ...interface{}) {
+	p.errs.Add(p.g.position(pos), fmt.Sprintf(format, args...))
+}
+
+func (p *parser) errorExpected(what string) {
+	p.errorf(p.tok().pos, "expected %s, found %s", what, p.tok())
+}
+
+func (p *parser) expect(id int) item {
+	t := p.tok()
+	if t.id != id {
+		p.errorExpected(tokenNames[id])
+	}
+	p.next()
+	return t
+}
+
+// define adds a production or, if incremental, adds alternatives to an
+// existing production.
+func (p *parser) define(pos int, n string, x expr, incremental bool) {
+	key := p.g.key(n)
+	prev, ok := p.g.m[key]
+	switch {
+	case incremental && !ok:
+		p.errorf(pos, "%s is not defined before =/", n)
+	case incremental:
+		alts, _ := prev.expr.(alternative)
+		if alts == nil {
+			alts = alternative{prev.expr}
+		}
+		if more, ok := x.(alternative); ok {
+			alts = append(alts, more...)
+		} else {
+			alts = append(alts, x)
+		}
+		prev.expr = alts
+	case ok:
+		p.errorf(pos, "%s redeclared, previous declaration at %s", n, p.g.position(prev.pos))
+	default:
+		prod := &production{pos: pos, name: n, expr: x}
+		p.g.m[key] = prod
+		p.g.productions = append(p.g.productions, prod)
+	}
+}
@@ -0,0 +1,180 @@
+package ebnf
+
+import (
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"h12.io/dfa"
+	"h12.io/gombi/scan"
+)
+
+const (
+	tEOF = iota
+	tIllegal
+	tSpace
+	tName
+	tString
+	tEllipsis
+	tDefine
+	tIncDefine
+	tBar
+	tSlash
+	tLParen
+	tRParen
+	tLBrack
+	tRBrack
+	tLBrace
+	tRBrace
+	tPeriod
+	tRepeat
+	tNumVal
+	tProse
+)
+
+var tokenNames = [...]string{
+	tEOF:       "EOF",
+	tIllegal:   "illegal character",
+	tName:      "name",
+	tString:    "string",
+	tEllipsis:  "'…'",
+	tDefine:    "'='",
+	tIncDefine: "'=/'",
+	tBar:       "'|'",
+	tSlash:     "'/'",
+	tLParen:    "'('",
+	tRParen:    "')'",
+	tLBrack:    "'['",
+	tRBrack:    "']'",
+	tLBrace:    "'{'",
+	tRBrace:    "'}'",
+	tPeriod:    "'.'",
+	tRepeat:    "repeat",
+	tNumVal:    "numeric value",
+	tProse:     "prose value",
+}
+
+var (
+	ebnfOnce, abnfOnce       sync.Once
+	ebnfMatcher, abnfMatcher *scan.Matcher
+)
+
+func getEBNFMatcher() *scan.Matcher {
+	ebnfOnce.Do(func() {
+		var (
+			c     = scan.Char
+			b     = scan.Between
+			s     = scan.Str
+			con   = scan.Con
+			or    = scan.Or
+			class = scan.CharClass
+
+			valid       = b(1, utf8.MaxRune)
+			newline     = s("\n")
+			letter      = or(class(`L`), c(`_`))
+			digit       = class(`Nd`)
+			escapedChar = con(`\`, valid.Exclude(newline))
+			interpreted = con(`"`, or(valid.Exclude(c("\"\\\n")), escapedChar).Repeat(), `"`)
+			raw         = con("`", valid.Exclude("`").Repeat(), "`")
+			lineComment = con(`//`, valid.Exclude(newline).Repeat())
+		)
+		ebnfMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{M: or(c(" \t\r\n").AtLeast(1), lineComment), ID: tSpace},
+			{M: con(letter, or(letter, digit).Repeat()), ID: tName},
+			{M: or(interpreted, raw), ID: tString},
+			{M: or(`…`, `...`), ID: tEllipsis},
+			{M: `=`, ID: tDefine},
+			{M: `|`, ID: tBar},
+			{M: `(`, ID: tLParen},
+			{M: `)`, ID: tRParen},
+			{M: `[`, ID: tLBrack},
+			{M: `]`, ID: tRBrack},
+			{M: `{`, ID: tLBrace},
+			{M: `}`, ID: tRBrace},
+			{M: `.`, ID: tPeriod},
+		})
+	})
+	return ebnfMatcher
+}
+
+func getABNFMatcher() *scan.Matcher {
+	abnfOnce.Do(func() {
+		var (
+			c   = scan.Char
+			b   = scan.Between
+			s   = scan.Str
+			con = scan.Con
+			or  = scan.Or
+
+			alpha   = or(b('a', 'z'), b('A', 'Z'))
+			digit   = b('0', '9')
+			hexDig  = or(digit, b('a', 'f'), b('A', 'F'))
+			vchar   = b(0x21, 0x7e)
+			newline = s("\n")
+			comment = con(`;`, or(vchar, c(" \t\r")).Repeat(), newline.Optional())
+			numVal  = func(base string, dig *dfa.M) *dfa.M {
+				digs := dig.AtLeast(1)
+				return con(`%`, c(base), digs, or(
+					con(`.`, digs).AtLeast(1),
+					con(`-`, digs),
+				).Optional())
+			}
+		)
+		abnfMatcher = scan.NewMatcher(tEOF, tIllegal, []scan.MID{
+			{M: or(c(" \t\r\n").AtLeast(1), comment), ID: tSpace},
+			{M: con(alpha, or(alpha, digit, `-`).Repeat()), ID: tName},
+			{M: con(or(`%s`, `%i`).Optional(), `"`, or(b(0x20, 0x21), b(0x23, 0x7e)).Repeat(), `"`), ID: tString},
+			{M: `=`, ID: tDefine},
+			{M: `=/`, ID: tIncDefine},
+			{M: `/`, ID: tSlash},
+			{M: `(`, ID: tLParen},
+			{M: `)`, ID: tRParen},
+			{M: `[`, ID: tLBrack},
+			{M: `]`, ID: tRBrack},
+			{M: or(con(digit.Repeat(), `*`, digit.Repeat()), digit.AtLeast(1)), ID: tRepeat},
+			{M: or(numVal("bB", c("01")), numVal("dD", digit), numVal("xX", hexDig)), ID: tNumVal},
+			{M: con(`<`, or(b(0x20, 0x3d), b(0x3f, 0x7e)).Repeat(), `>`), ID: tProse},
+		})
+	})
+	return abnfMatcher
+}
+
+type item struct {
+	id  int
+	pos int // byte offset in the source
+	lit string
+}
+
+func (t item) String() string {
+	switch t.id {
+	case tName, tString, tNumVal, tProse, tRepeat:
+		return fmt.Sprintf("%s %s", tokenNames[t.id], t.lit)
+	case tIllegal:
+		return fmt.Sprintf("%s %q", tokenNames[t.id], t.lit)
+	}
+	return tokenNames[t.id]
+}
+
+// tokenize scans src into tokens without spaces and comments. The last token
+// is always EOF.
+func tokenize(m *scan.Matcher, src []byte) (toks []item) {
+	s := scan.Scanner{Matcher: m}
+	s.SetSource(src)
+	for s.Scan() {
+		t := s.Token()
+		switch t.ID {
+		case tSpace:
+			continue
+		case tIllegal:
+			_, size := utf8.DecodeRune(src[t.Lo:])
+			toks = append(toks, item{tIllegal, t.Lo, string(src[t.Lo : t.Lo+size])})
+			s.SetPos(t.Lo + size)
+			continue
+		}
+		toks = append(toks, item{t.ID, t.Lo, string(src[t.Lo:t.Hi])})
+		if t.ID == tEOF {
+			break
+		}
+	}
+	return
+}
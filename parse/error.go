@@ -0,0 +1,103 @@
+package parse
+
+import "fmt"
+
+// Error is a syntax error found by Parser.
+type Error struct {
+	Token    *Token // the unexpected token
+	Term     *R     // the terminal rule of the unexpected token
+	Expected Rules  // the terminal rules that could have been accepted
+	Inserted *R     // the terminal inserted before Token during recovery, if any
+}
+
+func (e *Error) Error() string {
+	if len(e.Expected) == 0 {
+		return fmt.Sprintf("%d: unexpected %s", e.Token.Pos, e.found())
+	}
+	return fmt.Sprintf("%d: unexpected %s, expected %s", e.Token.Pos, e.found(), e.Expected.toString(" or "))
+}
+
+func (e *Error) found() string {
+	if e.Term == EOF {
+		return "EOF"
+	}
+	s := e.Term.Name()
+	if v := string(e.Token.Value); v != "" && v != s {
+		s += " " + fmt.Sprintf("%q", v)
+	}
+	return s
+}
+
+// ErrorList is a list of *Errors in the order they are found.
+type ErrorList []*Error
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Err returns an error equivalent to this error list.
+// If the list is empty, Err returns nil.
+func (l ErrorList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+// termCollector collects the terminals that can follow the dot of states.
+type termCollector struct {
+	m       map[*R]bool
+	visited map[*matchingRule]map[int]bool
+}
+
+func newTermCollector() *termCollector {
+	return &termCollector{
+		m:       make(map[*R]bool),
+		visited: make(map[*matchingRule]map[int]bool),
+	}
+}
+
+func (c *termCollector) addAlt(a *Alt) {
+	for t := range a.termSet {
+		c.m[t.R] = true
+	}
+}
+
+// afterComplete collects the terminals that can follow the completed state s.
+func (c *termCollector) afterComplete(s *state) {
+	for _, parent := range s.parents {
+		c.at(parent, parent.d+1)
+	}
+}
+
+// at collects the terminals that can be accepted by state s with dot d.
+func (c *termCollector) at(s *state, d int) {
+	ds := c.visited[s.matchingRule]
+	if ds == nil {
+		ds = make(map[int]bool)
+		c.visited[s.matchingRule] = ds
+	}
+	if ds[d] {
+		return
+	}
+	ds[d] = true
+	for ; d < len(s.Rules); d++ {
+		for _, alt := range s.Rules[d].Alts {
+			c.addAlt(alt)
+		}
+		if s.Rules[d].nullAlt == nil {
+			return
+		}
+	}
+	c.afterComplete(s)
+}
+
+func (c *termCollector) sorted() Rules {
+	return sortRules(c.m)
+}
@@ -0,0 +1,161 @@
+package parse
+
+/*
+Disambiguation filters
+
+Filters are applied during the completion of a rule, so that the rejected
+derivations are never packed into the parse forest:
+1. Prec and associativity (Left, Right, NonAssoc) applies to an alternative
+   that refers to its own rule at its leftmost or rightmost position, like
+   E ::= E + E. A child derivation of a lower precedence is rejected at either
+   edge, and a child derivation of the same precedence is rejected according
+   to the associativity.
+2. NotFollowedBy rejects a derivation followed by one of the given terminals.
+3. Reject rejects a derivation whose tokens can also be derived from another
+   rule.
+*/
+
+type assoc int
+
+const (
+	noAssoc assoc = iota
+	leftAssoc
+	rightAssoc
+	nonAssoc
+)
+
+type filter struct {
+	prec          int
+	assoc         assoc
+	notFollowedBy map[*R]bool
+	reject        *R // the root rule for the sub-parser of Reject
+}
+
+// Prec sets the precedence of the alternatives of r, an alternative of a higher
+// precedence binds tighter. Zero means no precedence.
+func (r *R) Prec(n int) *R {
+	r.eachFilter(func(f *filter) { f.prec = n })
+	return r
+}
+
+// Left marks the alternatives of r as left associative.
+func (r *R) Left() *R {
+	r.eachFilter(func(f *filter) { f.assoc = leftAssoc })
+	return r
+}
+
+// Right marks the alternatives of r as right associative.
+func (r *R) Right() *R {
+	r.eachFilter(func(f *filter) { f.assoc = rightAssoc })
+	return r
+}
+
+// NonAssoc marks the alternatives of r as non-associative, so that they cannot
+// be nested at an edge without parentheses.
+func (r *R) NonAssoc() *R {
+	r.eachFilter(func(f *filter) { f.assoc = nonAssoc })
+	return r
+}
+
+// NotFollowedBy rejects the derivations of r that are immediately followed by
+// any of the terminals.
+func (r *R) NotFollowedBy(terms ...*R) *R {
+	r.eachFilter(func(f *filter) {
+		if f.notFollowedBy == nil {
+			f.notFollowedBy = make(map[*R]bool)
+		}
+		for _, t := range terms {
+			f.notFollowedBy[t] = true
+		}
+	})
+	return r
+}
+
+// Reject rejects the derivations of r whose tokens can also be derived from x,
+// e.g. an identifier that is a keyword.
+func (r *R) Reject(x *R) *R {
+	root := con(x, EOF)
+	root.InitTermSet()
+	r.eachFilter(func(f *filter) { f.reject = root })
+	return r
+}
+
+func (r *R) eachFilter(visit func(*filter)) {
+	for _, a := range r.Alts {
+		if a.filter == nil {
+			a.filter = &filter{}
+		}
+		visit(a.filter)
+	}
+}
+
+// acceptsChild returns false if the derivation of alternative c cannot be the
+// i-th child of alternative a because of precedence or associativity.
+func (a *Alt) acceptsChild(i int, c *Alt) bool {
+	pf, cf := a.filter, c.filter
+	if pf == nil || cf == nil || c.R != a.R || a.Rules[i] != a.R {
+		return true
+	}
+	first, last := i == 0, i == len(a.Rules)-1
+	if !first && !last {
+		return true
+	}
+	if cf.prec != 0 && cf.prec < pf.prec {
+		return false
+	}
+	if c == a || (pf.prec != 0 && cf.prec == pf.prec) {
+		switch pf.assoc {
+		case leftAssoc:
+			return first
+		case rightAssoc:
+			return last
+		case nonAssoc:
+			return false
+		}
+	}
+	return true
+}
+
+// accepts returns false if the completed state s is rejected by the filters of
+// its alternative when followed by the terminal of alternative next.
+func (s *state) accepts(next *Alt) bool {
+	f := s.Alt.filter
+	if f == nil {
+		return true
+	}
+	if next != nil && f.notFollowedBy[next.R] {
+		return false
+	}
+	if f.reject != nil && f.rejects(s.node) {
+		return false
+	}
+	return true
+}
+
+// rejects parses the tokens of n with the rule of Reject.
+func (f *filter) rejects(n *Node) bool {
+	p := New(f.reject)
+	ok := true
+	n.eachLeaf(func(leaf *Node) {
+		if ok {
+			ok = p.Parse(leaf.token, leaf.alt.R)
+		}
+	})
+	if ok {
+		p.Parse(&Token{}, EOF)
+	}
+	return len(p.Results()) > 0
+}
+
+func (n *Node) eachLeaf(visit func(*Node)) {
+	if n == nil {
+		return
+	}
+	if n.token != nil {
+		visit(n)
+		return
+	}
+	for _, child := range n.values {
+		child.eachLeaf(visit)
+	}
+}
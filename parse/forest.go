@@ -0,0 +1,172 @@
+package parse
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A parse result is a shared packed parse forest: a subtree is shared by all
+// the derivations containing it, and the derivations of the same rule over the
+// same tokens are packed into one ambiguous node. An ambiguous node behaves
+// like its first derivation, so a Node can be used as a tree when ambiguity
+// does not matter.
+
+// Ambiguous returns true if more than one derivation is packed in n.
+func (n *Node) Ambiguous() bool {
+	return n != nil && n.packed != nil
+}
+
+// Alternatives returns the derivations packed in an ambiguous node, or n itself
+// if n is not ambiguous. The children of a derivation may still be ambiguous.
+func (n *Node) Alternatives() []*Node {
+	if !n.Ambiguous() {
+		return []*Node{n}
+	}
+	var alts []*Node
+	for _, a := range n.packed {
+		alts = append(alts, a.Alternatives()...)
+	}
+	return alts
+}
+
+// DerivationCount returns the number of parse trees represented by n.
+func (n *Node) DerivationCount() *big.Int {
+	return n.countDerivations(make(map[*Node]*big.Int))
+}
+
+func (n *Node) countDerivations(memo map[*Node]*big.Int) *big.Int {
+	if n == nil {
+		return big.NewInt(1)
+	}
+	if c, ok := memo[n]; ok {
+		return c
+	}
+	c := big.NewInt(0)
+	if n.Ambiguous() {
+		for _, a := range n.Alternatives() {
+			c.Add(c, a.countDerivations(memo))
+		}
+	} else {
+		c.SetInt64(1)
+		for _, child := range n.values {
+			c.Mul(c, child.countDerivations(memo))
+		}
+	}
+	memo[n] = c
+	return c
+}
+
+// EachDerivation calls visit with each parse tree represented by n until visit
+// returns false. The trees are built lazily, so that the enumeration can be
+// stopped early even when the number of derivations is exponential.
+func (n *Node) EachDerivation(visit func(*Node) bool) {
+	n.derive(visit)
+}
+
+func (n *Node) derive(visit func(*Node) bool) bool {
+	if n == nil || (!n.Ambiguous() && len(n.values) == 0) {
+		return visit(n)
+	}
+	if n.Ambiguous() {
+		for _, a := range n.Alternatives() {
+			if !a.derive(visit) {
+				return false
+			}
+		}
+		return true
+	}
+	values := make([]*Node, len(n.values))
+	var deriveFrom func(i int) bool
+	deriveFrom = func(i int) bool {
+		if i == len(values) {
+			return visit(&Node{alt: n.alt, token: n.token, values: append([]*Node(nil), values...)})
+		}
+		return n.values[i].derive(func(c *Node) bool {
+			values[i] = c
+			return deriveFrom(i + 1)
+		})
+	}
+	return deriveFrom(0)
+}
+
+// Ambiguity describes an ambiguous node in a parse forest.
+type Ambiguity struct {
+	Rule         *R
+	First        *Token // the first token covered, nil if no token is covered
+	Last         *Token // the last token covered, nil if no token is covered
+	Alternatives int    // the number of derivations packed in the node
+}
+
+func (a Ambiguity) String() string {
+	if a.First == nil {
+		return fmt.Sprintf("%s is ambiguous with %d alternatives", a.Rule.Name(), a.Alternatives)
+	}
+	return fmt.Sprintf("%d-%d: %s is ambiguous with %d alternatives",
+		a.First.Pos, a.Last.Pos+len(a.Last.Value), a.Rule.Name(), a.Alternatives)
+}
+
+// Ambiguities returns all the ambiguous nodes within n, outer nodes first.
+func (n *Node) Ambiguities() (as []Ambiguity) {
+	visited := make(map[*Node]bool)
+	var walk func(*Node)
+	walk = func(n *Node) {
+		if n == nil || visited[n] {
+			return
+		}
+		visited[n] = true
+		alts := n.Alternatives()
+		if len(alts) > 1 {
+			as = append(as, Ambiguity{
+				Rule:         n.Rule(),
+				First:        n.firstToken(),
+				Last:         n.lastToken(),
+				Alternatives: len(alts),
+			})
+		}
+		for _, a := range alts {
+			for _, child := range a.values {
+				walk(child)
+			}
+		}
+	}
+	walk(n)
+	return
+}
+
+// Ambiguities returns the ambiguous nodes of the parse result.
+func (p *Parser) Ambiguities() []Ambiguity {
+	if len(p.results) == 0 {
+		return nil
+	}
+	return p.results[0].Ambiguities()
+}
+
+func (n *Node) firstToken() *Token {
+	if n == nil {
+		return nil
+	}
+	if n.token != nil {
+		return n.token
+	}
+	for _, child := range n.values {
+		if t := child.firstToken(); t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+func (n *Node) lastToken() *Token {
+	if n == nil {
+		return nil
+	}
+	if n.token != nil {
+		return n.token
+	}
+	for i := len(n.values) - 1; i >= 0; i-- {
+		if t := n.values[i].lastToken(); t != nil {
+			return t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package parse
+
+/*
+Incremental parsing
+
+A parser state after each token is persistent: a state set only creates new
+states and modifies the nodes created within itself, so the states of the
+previous tokens can be restored. In the incremental mode, the parser keeps a
+checkpoint after each token, and after an edit of the source, Rewind restores
+the parser to the last token before the edit, so that the subtrees of the
+tokens before the edit are shared by the new result. After the new tokens of
+the edit are parsed, Resume parses the old tokens after the edit again until
+the pending Earley items become the same as the ones of the last parse at the
+same token, i.e. the parse of the rest of the tokens cannot differ from the
+last one. From there on, the subtrees of the last result are reused in place
+of their tokens instead of being parsed again.
+
+The tokens need not be scanned again either, see scan.Tokens.Relex.
+*/
+
+type checkpoint struct {
+	s        *state // nil within a reused subtree, see Rewind
+	errors   int
+	skipping bool
+	t        *Token
+	tr       *R
+}
+
+// lastParse is the parse saved by Rewind for Resume.
+type lastParse struct {
+	checkpoints []checkpoint
+	eof         *Token
+	result      *Node // nil if the subtrees cannot be reused
+}
+
+// SetIncremental enables or disables the incremental mode, see Rewind. It must
+// be called before parsing.
+func (p *Parser) SetIncremental(enable bool) {
+	p.incremental = enable
+	p.checkpoints = nil
+	p.last = nil
+}
+
+// Parsed returns the number of tokens parsed in the incremental mode, not
+// counting EOF.
+func (p *Parser) Parsed() int {
+	return len(p.checkpoints)
+}
+
+// Rewind restores the parser to the state after the first n tokens have been
+// parsed in the incremental mode, so that the following tokens can be parsed
+// again after an edit. The last parse is kept for Resume.
+func (p *Parser) Rewind(n int) {
+	if !p.incremental || n > len(p.checkpoints) {
+		panic("parse: rewind beyond the parsed tokens in incremental mode")
+	}
+	p.last = &lastParse{
+		checkpoints: append([]checkpoint(nil), p.checkpoints...),
+		eof:         p.eof,
+	}
+	if len(p.results) == 1 && len(p.errors) == 0 && !hasReject(p.r) {
+		p.last.result = p.results[0]
+	}
+	p.results = nil
+	p.eof = nil
+	// the states within a reused subtree are not kept, so the tokens from the
+	// start of the subtree are parsed again.
+	m := n
+	for m > 0 && p.checkpoints[m-1].s == nil {
+		m--
+	}
+	if m == 0 {
+		p.s = nil
+		p.errors = nil
+		p.skipping = false
+	} else {
+		c := p.checkpoints[m-1]
+		p.s = c.s
+		p.errors = p.errors[:c.errors]
+		p.skipping = c.skipping
+	}
+	p.checkpoints = p.checkpoints[:m]
+	for _, c := range p.last.checkpoints[m:n] {
+		p.Parse(c.t, c.tr)
+	}
+}
+
+// Resume parses the tokens of the last parse after an edit, following the
+// new tokens parsed since Rewind. oldHi is the index of the first old token
+// after the edit, and delta is the change of the source length by the edit.
+// e.g. for the edit scanned by scan.Tokens.Relex, the parser is rewound to
+// lo, the new tokens [lo, newHi) are parsed and then Resume(oldHi, delta) is
+// called. The token indexes are the ones of the tokens passed to Parse.
+//
+// The positions of the old tokens after the edit are shifted by delta in
+// place, and the subtrees of the last result after the edit are shared by the
+// new result, so the last result must not be used after Resume. The subtrees
+// are only reused if the last parse had no syntax error and the rules have no
+// Reject filter, because the rejection depends on the tokens before the edit.
+func (p *Parser) Resume(oldHi, delta int) {
+	last := p.last
+	if last == nil {
+		panic("parse: resume without rewind in incremental mode")
+	}
+	p.last = nil
+	cs := last.checkpoints
+	if oldHi > len(cs) || (len(p.errors) > 0 && !p.recovery) {
+		return // EOF has been parsed within the edit, or a new token has failed
+	}
+	for _, c := range cs[oldHi:] {
+		c.t.Pos += delta
+	}
+	last.eof.Pos += delta
+	var subtrees map[int]subtree
+	if last.result != nil {
+		subtrees = make(map[int]subtree)
+		last.result.collectSubtrees(0, len(cs), subtrees, true)
+	}
+	converged := false
+	for i := oldHi; i < len(cs); {
+		if subtrees != nil && !converged {
+			converged = p.sameItems(cs, i)
+		}
+		if converged {
+			if st, ok := subtrees[i]; ok && p.reuse(st, cs[i:st.hi]) {
+				i = st.hi
+				continue
+			}
+		}
+		if !p.Parse(cs[i].t, cs[i].tr) {
+			return
+		}
+		i++
+	}
+	p.Parse(last.eof, EOF)
+}
+
+// subtree is a node of the last result with the index after its last token.
+type subtree struct {
+	*Node
+	hi int
+}
+
+// collectSubtrees collects the outermost subtree starting at each token, not
+// containing the token at eof, and returns the index after the last token of
+// n. The derivations packed in an ambiguous node may split the tokens in
+// different ways, so the nodes within it are not collected.
+func (n *Node) collectSubtrees(lo, eof int, m map[int]subtree, collect bool) int {
+	if n == nil {
+		return lo
+	}
+	if n.token != nil {
+		return lo + 1
+	}
+	hi := lo
+	for _, c := range n.values {
+		hi = c.collectSubtrees(hi, eof, m, collect && !n.Ambiguous())
+	}
+	if collect && hi > lo && hi <= eof {
+		m[lo] = subtree{n, hi} // replaces the subtrees within n
+	}
+	return hi
+}
+
+// sameItems returns true if the pending Earley items are the same as the ones
+// of the last parse before the token at i. The items created after the edit
+// are compared by their alternatives, dot positions and parents, until they
+// reach the items shared by both parses.
+func (p *Parser) sameItems(cs []checkpoint, i int) bool {
+	if p.skipping {
+		return false
+	}
+	if i == 0 {
+		return p.s == nil
+	}
+	if cs[i-1].s == nil || p.s == nil {
+		return false
+	}
+	return sameState(p.s, cs[i-1].s, make(map[[2]*matchingRule]bool))
+}
+
+func sameState(a, b *state, seen map[[2]*matchingRule]bool) bool {
+	if a.d != b.d || a.Alt != b.Alt {
+		return false
+	}
+	if a.matchingRule == b.matchingRule {
+		return true
+	}
+	key := [2]*matchingRule{a.matchingRule, b.matchingRule}
+	if same, ok := seen[key]; ok {
+		return same
+	}
+	seen[key] = true // assumed while comparing a cycle of parents
+	same := len(a.parents) == len(b.parents)
+	for j := 0; same && j < len(a.parents); j++ {
+		same = sameState(a.parents[j], b.parents[j], seen)
+	}
+	seen[key] = same
+	return same
+}
+
+// reuse parses the tokens cs of subtree st by advancing the parser over st as
+// a whole, if the alternative of st is predicted by the first token.
+func (p *Parser) reuse(st subtree, cs []checkpoint) bool {
+	pset := p.predict(cs[0].tr)
+	predicted, ok := pset.m[st.alt]
+	if !ok {
+		return false
+	}
+	p.s = &state{matchingRule: predicted.matchingRule, d: len(st.alt.Rules), node: st.Node}
+	// p.s is not the state after the last token either, because it has no item
+	// within st, e.g. a nullable rule at the end of st that could still derive
+	// the next tokens.
+	for _, c := range cs {
+		c.s, c.errors, c.skipping = nil, len(p.errors), false
+		p.checkpoints = append(p.checkpoints, c)
+	}
+	return true
+}
+
+func (p *Parser) checkpoint(t *Token, tr *R) {
+	if p.incremental {
+		p.checkpoints = append(p.checkpoints, checkpoint{p.s, len(p.errors), p.skipping, t, tr})
+	}
+}
+
+func hasReject(r *R) (reject bool) {
+	r.eachReachable(make(map[*R]bool), func(r *R) {
+		for _, a := range r.Alts {
+			if a.filter != nil && a.filter.reject != nil {
+				reject = true
+			}
+		}
+	})
+	return
+}
@@ -0,0 +1,125 @@
+package parse
+
+import (
+	"h12.io/gspec"
+)
+
+var _ = gspec.Add(func(s gspec.S) {
+	describe, testcase, given := gspec.Alias3("describe", "testcase:", "given", s)
+
+	describe("the incremental parser", func() {
+
+		given("simple arithmetic grammar", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				T    = Term("T")
+				Plus = Term(`+`)
+				Mult = Term(`*`)
+				M    = NewRule().As("M")
+				_    = M.Define(Or(
+					T,
+					Con(M, Mult, T),
+				))
+				S = NewRule().As("S")
+				_ = S.Define(Or(
+					Con(S, Plus, M),
+					M,
+				))
+				P = Con(S, EOF).As("P")
+			)
+			P.InitTermSet()
+			parse := func(p *Parser, tokens TT) {
+				for _, t := range tokens {
+					p.Parse(t.t, t.r)
+				}
+			}
+			testcase("rewind and reparse", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := New(P)
+				p.SetIncremental(true)
+				tokens := TT{tok("1", T), tok("+", Plus), tok("2", T), tok("*", Mult), tok("3", T), tok("", EOF)}
+				parse(p, tokens)
+				expect(p.Parsed()).Equal(5)
+				old := p.Results()[0]
+
+				// 1 + 2 * 3 -> 1 + 4 + 5
+				p.Rewind(2)
+				parse(p, TT{tok("4", T), tok("+", Plus), tok("5", T), tok("", EOF)})
+				expect(p.Parsed()).Equal(5)
+				expect(len(p.Results())).Equal(1)
+				want := parseAll(P, TT{tok("1", T), tok("+", Plus), tok("4", T), tok("+", Plus), tok("5", T)})
+				expect(p.Results()[0].String()).Equal(want.Results()[0].String())
+				expect(p.Results()[0].leftmostLeaf() == old.leftmostLeaf()).Equal(true)
+
+				// back to the original tokens
+				p.Rewind(2)
+				parse(p, tokens[2:])
+				expect(p.Results()[0].String()).Equal(old.String())
+			})
+			// each byte is a token at its offset
+			lex := func(src string, off int) (tokens TT) {
+				for i, c := range src {
+					r := T
+					switch c {
+					case '+':
+						r = Plus
+					case '*':
+						r = Mult
+					}
+					tokens = append(tokens, tokAt(string(c), r, off+i))
+				}
+				return
+			}
+			testcase("resume with the subtrees after an edit", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := New(P)
+				p.SetIncremental(true)
+				parse(p, append(lex("1*2+3*4+5*6+7*8", 0), tokAt("", EOF, 15)))
+				old := p.Results()[0]
+				m56, m78 := old.Child(0).Child(0).Child(2), old.Child(0).Child(2)
+
+				// 3 -> 9*9, the tokens from 4 are the same as the ones from 6
+				p.Rewind(4)
+				parse(p, lex("9*9", 4))
+				p.Resume(5, 2)
+				expect(p.Parsed()).Equal(17)
+				want := parseAll(P, lex("1*2+9*9*4+5*6+7*8", 0))
+				expect(p.Results()[0].String()).Equal(want.Results()[0].String())
+				n := p.Results()[0]
+				expect("reused 5*6", n.Child(0).Child(0).Child(2) == m56).Equal(true)
+				expect("reused 7*8", n.Child(0).Child(2) == m78).Equal(true)
+				expect(m78.Pos()).Equal(14)
+
+				// 6 -> 7, the parser is rewound to the start of the reused 5*6
+				p.Rewind(12)
+				expect(p.Parsed()).Equal(12)
+				parse(p, lex("7", 12))
+				p.Resume(13, 0)
+				want = parseAll(P, lex("1*2+9*9*4+5*7+7*8", 0))
+				expect(p.Results()[0].String()).Equal(want.Results()[0].String())
+				expect("reused 7*8", p.Results()[0].Child(0).Child(2) == m78).Equal(true)
+			})
+			testcase("rewind the errors", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := New(P)
+				p.SetIncremental(true)
+				p.SetRecovery(true)
+				parse(p, TT{tok("1", T), tok("+", Plus), tokAt("+", Plus, 2), tok("2", T), tok("", EOF)})
+				expect(len(p.Errors())).Equal(1)
+				expect(p.Parsed()).Equal(4)
+				p.Rewind(2)
+				parse(p, TT{tok("2", T), tok("", EOF)})
+				expect(p.Error()).Equal(nil)
+				expect(len(p.Results())).Equal(1)
+			})
+		})
+	})
+})
+
+func (n *Node) leftmostLeaf() *Node {
+	for len(n.values) > 0 {
+		n = n.values[0]
+	}
+	return n
+}
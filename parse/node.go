@@ -4,6 +4,10 @@ type Node struct {
 	alt    *Alt
 	token  *Token
 	values []*Node
+	packed []*Node // alternative derivations when ambiguous, see forest.go
+
+	val       interface{} // the value evaluated by actions, see action.go
+	evaluated bool
 }
 type Token struct {
 	ID    int
@@ -11,14 +15,44 @@ type Token struct {
 	Pos   int
 }
 
-func (n *Node) copy() *Node {
+// extend returns a copy of n with the i-th child set to c, n can be nil when
+// no child has been set yet. All the derivations packed in n are extended.
+func (n *Node) extend(alt *Alt, i int, c *Node) *Node {
+	m := &Node{alt: alt, values: make([]*Node, len(alt.Rules))}
 	if n == nil {
-		return nil
+		m.values[i] = c
+		return m
+	}
+	copy(m.values, n.values)
+	m.values[i] = c
+	if n.packed != nil {
+		m.packed = make([]*Node, len(n.packed))
+		m.packed[0] = &Node{alt: alt, values: m.values} // shares values with m
+		for j, f := range n.packed[1:] {
+			m.packed[j+1] = f.extend(alt, i, c)
+		}
+	}
+	return m
+}
+
+// families returns the derivations of a node that are modifiable in place.
+// The first one always shares its children with n.
+func (n *Node) families() []*Node {
+	if n.packed == nil {
+		return []*Node{n}
 	}
-	node := *n
-	n = &node
-	n.values = append([]*Node(nil), n.values...)
-	return n
+	return n.packed
+}
+
+// packNodes returns an ambiguous node with derivations of both a and b. a and
+// b are referenced rather than copied because they may still be packed with
+// more derivations within the same state set. The value of a is kept, see
+// action.go.
+func packNodes(a, b *Node) *Node {
+	if a == b {
+		return a
+	}
+	return &Node{alt: a.alt, token: a.token, values: a.values, packed: []*Node{a, b}, val: a.val, evaluated: a.evaluated}
 }
 
 func (n *Node) Rule() *R {
@@ -52,30 +86,30 @@ func (n *Node) Value() []byte {
 }
 
 func (n *Node) Pos() int {
-	if n == nil {
-		return 0
+	if t := n.firstToken(); t != nil {
+		return t.Pos
 	}
-	return n.token.Pos
+	return 0
 }
 
 func (n *Node) Is(r *R) bool {
 	return n.Rule() == r
 }
 
+// EachItem visits the items of a node of a rule defined by AtLeast(1).
 func (n *Node) EachItem(visit func(*Node)) {
-	if n == nil {
+	if n == nil || n.alt.R.list != oneOrMore {
 		return
 	}
+	// the items are collected backwards from the left recursion r+ ::= r+ r
+	var items []*Node
 	cur := n
-Loop:
-	for {
-		if cur.alt == cur.alt.R.Alts[0] {
-			visit(cur)
-			break Loop
-		} else {
-			visit(cur.Child(0))
-			cur = cur.Child(1)
-		}
+	for ; cur.alt != cur.alt.R.Alts[0]; cur = cur.Child(0) {
+		items = append(items, cur.Child(1))
+	}
+	visit(cur.Child(0))
+	for i := len(items) - 1; i >= 0; i-- {
+		visit(items[i])
 	}
 }
 
@@ -1,71 +1,208 @@
 package parse
 
 type Parser struct {
-	r       *R
-	s       *state
-	results []*Node
+	r        *R
+	s        *state
+	results  []*Node
+	errors   ErrorList
+	recovery bool
+	skipping bool
+	eval     bool // any action is attached, see action.go
+	release  bool // release the children of evaluated nodes
+
+	incremental bool
+	checkpoints []checkpoint // the states after each token, see incremental.go
+	eof         *Token
+	last        *lastParse
 }
 
 func New(r *R) *Parser {
 	p := &Parser{r: r}
+	p.eval, p.release = hasActions(r)
 	p.Reset()
 	return p
 }
 func (p *Parser) Reset() {
 	p.results = nil
 	p.s = nil
+	p.errors = nil
+	p.skipping = false
+	p.checkpoints = nil
+	p.eof = nil
+	p.last = nil
+}
+
+// SetRecovery enables or disables error recovery. When enabled, a token that
+// cannot be accepted is reported as an Error and then either repaired by
+// inserting a single missing terminal or skipped, so that parsing continues
+// and multiple syntax errors can be reported for one input.
+func (p *Parser) SetRecovery(enable bool) {
+	p.recovery = enable
 }
 
 func (p *Parser) Parse(t *Token, tr *R) bool {
-	pset := newStateSet(tr.Alts[0])
-	if p.s == nil {
-		for _, alt := range p.r.Alts {
-			pset.predictNext(newState(alt))
-		}
-	} else {
-		pset.predict(p.s)
-		p.s.parents = nil
+	if tr == EOF {
+		p.eof = t // parsed again by Resume
 	}
-	p.s = pset.termState
+	pset := p.predict(tr)
 	//fmt.Printf("### predict set ->\n%s\n", pset.String())
 	//fmt.Println()
 	//fmt.Printf("### term state ->\n%s\n", pset.termState.dumpUp(0))
 	//fmt.Println()
-	if p.s == nil {
-		return false
+	if pset.termState == nil {
+		var ok bool
+		if pset, ok = p.recover(t, tr); !ok {
+			if p.recovery && tr != EOF {
+				p.checkpoint(t, tr)
+				return true
+			}
+			return false
+		}
+	}
+	p.skipping = false
+	if p.s != nil && !p.incremental {
+		p.s.parents = nil
 	}
+	p.s = pset.termState
 	p.s.scan(t)
+	if p.release && !p.incremental {
+		pset.releaseCompleted()
+	}
 	if tr == EOF {
-		p.collectResult(p.s)
+		cset := newStateSet(EOF.Alts[0])
+		cset.eval = p.eval
+		p.collectResult(&cset, p.s)
 		return false
 	}
+	p.checkpoint(t, tr)
 	return true
 }
 
-func (p *Parser) collectResult(s *state) {
-	if s.complete() {
-		if s.rule() == p.r {
-			p.results = append(p.results, s.node)
+// predict returns the state set predicted from the current state with the
+// terminal tr as the lookahead.
+func (p *Parser) predict(tr *R) stateSet {
+	return predictFrom(p.r, p.s, tr, p.eval)
+}
+
+func predictFrom(r *R, s *state, tr *R, eval bool) stateSet {
+	pset := newStateSet(tr.Alts[0])
+	pset.eval = eval
+	if s == nil {
+		for _, alt := range r.Alts {
+			pset.predictNext(newState(alt))
+		}
+	} else {
+		pset.predict(s)
+	}
+	return pset
+}
+
+// recover reports an unexpected token and, if recovery is enabled, tries to
+// repair the input by inserting one of the expected terminals before it.
+func (p *Parser) recover(t *Token, tr *R) (stateSet, bool) {
+	err := &Error{Token: t, Term: tr, Expected: p.Expected()}
+	if !p.recovery {
+		p.errors = append(p.errors, err)
+		return stateSet{}, false
+	}
+	for _, r := range err.Expected {
+		if r == EOF {
+			continue
+		}
+		inserted := p.predict(r).termState
+		if inserted == nil {
+			continue
+		}
+		inserted.scan(&Token{Pos: t.Pos})
+		if pset := predictFrom(p.r, inserted, tr, p.eval); pset.termState != nil {
+			err.Inserted = r
+			p.errors = append(p.errors, err)
+			return pset, true
+		}
+	}
+	if !p.skipping {
+		p.errors = append(p.errors, err)
+	}
+	p.skipping = true // skip tokens and report no error until resynchronized
+	return stateSet{}, false
+}
+
+func (p *Parser) collectResult(ss *stateSet, s *state) {
+	if s.complete() && s.accepts(ss.termAlt) {
+		ss.evalCompleted(s)
+		if s.rule() == p.r && s.parents == nil {
+			// derivations from different alternatives of the root rule are
+			// packed into a single result.
+			if len(p.results) == 0 {
+				p.results = append(p.results, s.node)
+			} else {
+				p.results[0] = packNodes(p.results[0], s.node)
+			}
 		}
 		for _, parent := range s.parents {
-			p.collectResult(parent.advance(s))
+			if a, isNew := ss.advance(parent, s); isNew {
+				p.collectResult(ss, a)
+			}
+		}
+		if !p.incremental {
+			s.parents = nil
 		}
-		s.parents = nil // OK
 	}
 }
 
+// Error returns nil if no syntax error has been found, otherwise an ErrorList
+// containing all of them.
 func (p *Parser) Error() error {
-	return nil
+	return p.errors.Err()
+}
+
+// Errors returns the syntax errors found so far.
+func (p *Parser) Errors() ErrorList {
+	return p.errors
 }
 
+// Results returns the parse result after EOF is parsed. There is at most one
+// result, and exactly one once EOF is parsed without an error. All the
+// derivations of an ambiguous input are packed in it as a shared forest, see
+// Node.Ambiguous. The children of the nodes evaluated by actions are released,
+// see action.go.
 func (p *Parser) Results() []*Node {
 	return p.results
 }
 
+// Expected returns the terminal rules that can be accepted by the next call of
+// Parse, sorted by name. A terminal rejected by the disambiguation filters,
+// e.g. NotFollowedBy, is not expected.
+func (p *Parser) Expected() Rules {
+	c := newTermCollector()
+	if p.s == nil {
+		for _, alt := range p.r.Alts {
+			c.addAlt(alt)
+		}
+	} else {
+		c.afterComplete(p.s)
+	}
+	rules := c.sorted()
+	expected := rules[:0]
+	for _, r := range rules {
+		// the actions are not evaluated by the trial prediction
+		if predictFrom(p.r, p.s, r, false).termState != nil {
+			expected = append(expected, r)
+		}
+	}
+	return expected
+}
+
 func (pset *stateSet) predict(s *state) {
 	if s.complete() {
+		if !s.accepts(pset.termAlt) || pset.predicted(s) {
+			return
+		}
+		pset.evalCompleted(s)
 		for _, parent := range s.parents {
-			pset.predict(parent.advance(s))
+			if a, isNew := pset.advance(parent, s); isNew {
+				pset.predict(a)
+			}
 		}
 		return
 	}
@@ -74,12 +211,21 @@ func (pset *stateSet) predict(s *state) {
 
 func (pset *stateSet) predictNext(s *state) {
 	if s.d < len(s.Rules) {
-		for _, alt := range s.Rules[s.d].Alts {
+		r := s.Rules[s.d]
+		for _, alt := range r.Alts {
 			if alt.termSet[pset.termAlt] {
 				if child, isNew := pset.add(alt, s); isNew {
 					pset.predictNext(child)
 				}
 			}
 		}
+		if r.nullAlt != nil {
+			// skip the nullable rule without predicting its empty derivation
+			// (Aycock & Horspool, Practical Earley Parsing).
+			empty := &state{matchingRule: &matchingRule{Alt: r.nullAlt}, d: len(r.nullAlt.Rules), node: r.emptyNode()}
+			if a, isNew := pset.advance(s, empty); isNew {
+				pset.predict(a)
+			}
+		}
 	}
 }
@@ -37,6 +37,7 @@ var _ = gspec.Add(func(s gspec.S) {
 				T    = Term("T")
 				Plus = Term(`+`)
 				Mult = Term(`*`)
+				X    = Term("X")
 				M    = NewRule().As("M")
 				_    = M.Define(Or(
 					T,
@@ -93,6 +94,324 @@ var _ = gspec.Add(func(s gspec.S) {
 						T ::= 4
 				EOF ::= `)
 			})
+			testcase("unexpected token", func() {
+				testParseError(s, P, false, TT{
+					tokAt("1", T, 0),
+					tokAt("+", Plus, 1),
+					tokAt("+", Plus, 2),
+					tokAt("2", T, 3),
+				}, "", `2: unexpected +, expected T`)
+			})
+			testcase("unexpected EOF", func() {
+				testParseError(s, P, false, TT{
+					tokAt("1", T, 0),
+					tokAt("+", Plus, 1),
+				}, "", `0: unexpected EOF, expected T`)
+			})
+			testcase("recovery by inserting", func() {
+				testParseError(s, P, true, TT{
+					tokAt("1", T, 0),
+					tokAt("2", T, 1),
+				}, `
+				P ::= S EOF
+					S ::= M
+						M ::= M * T
+							M ::= T
+								T ::= 1
+							* ::= 
+							T ::= 2
+					EOF ::= `,
+					`1: unexpected T "2", expected * or + or EOF`,
+				)
+			})
+			testcase("recovery by skipping", func() {
+				testParseError(s, P, true, TT{
+					tokAt("1", T, 0),
+					tokAt("x", X, 1),
+					tokAt("x", X, 2),
+					tokAt("+", Plus, 3),
+					tokAt("2", T, 4),
+				}, `
+				P ::= S EOF
+					S ::= S + M
+						S ::= M
+							M ::= T
+								T ::= 1
+						+ ::= +
+						M ::= T
+							T ::= 2
+					EOF ::= `,
+					`1: unexpected X "x", expected * or + or EOF`,
+				)
+			})
+		})
+
+		given("an ambiguous grammar", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				T    = Term("T")
+				Plus = Term(`+`)
+				E    = NewRule().As("E")
+				_    = E.Define(Or(
+					Con(E, Plus, E),
+					T,
+				))
+				P = Con(E, EOF).As("P")
+			)
+			P.InitTermSet()
+			sum := func(n int) TT {
+				tokens := TT{tokAt("1", T, 0)}
+				for i := 1; i < n; i++ {
+					tokens = append(tokens, tokAt("+", Plus, 2*i-1), tokAt("1", T, 2*i))
+				}
+				return tokens
+			}
+			testcase("unambiguous", func() {
+				expect := gspec.Expect(s.FailNow)
+				parser := parseAll(P, sum(2))
+				expect(len(parser.Results())).Equal(1)
+				expect(parser.Results()[0].Ambiguous()).Equal(false)
+				expect(parser.Ambiguities()).Equal([]Ambiguity(nil))
+			})
+			testcase("packed derivations", func() {
+				expect := gspec.Expect(s.FailNow)
+				parser := parseAll(P, sum(3))
+				results := parser.Results()
+				expect(len(results)).Equal(1)
+				e := results[0].Child(0)
+				expect(e.Ambiguous()).Equal(true)
+				expect(len(e.Alternatives())).Equal(2)
+				expect(results[0].DerivationCount().Int64()).Equal(int64(2))
+				var trees []string
+				results[0].EachDerivation(func(n *Node) bool {
+					trees = append(trees, n.String())
+					return true
+				})
+				expect(len(trees)).Equal(2)
+				expect(trees[0] != trees[1]).Equal(true)
+				as := parser.Ambiguities()
+				expect(len(as)).Equal(1)
+				expect(as[0].String()).Equal("0-5: E is ambiguous with 2 alternatives")
+			})
+			testcase("shared forest", func() {
+				expect := gspec.Expect(s.FailNow)
+				parser := parseAll(P, sum(12))
+				results := parser.Results()
+				// the Catalan number C(11)
+				expect(results[0].DerivationCount().Int64()).Equal(int64(58786))
+				count := 0
+				results[0].EachDerivation(func(n *Node) bool {
+					count++
+					return count < 10
+				})
+				expect(count).Equal(10)
+			})
+		})
+
+		given("an operator grammar with precedence and associativity", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				T    = Term("T")
+				Eq   = Term(`=`)
+				Plus = Term(`+`)
+				Mult = Term(`*`)
+				Pow  = Term(`^`)
+				E    = NewRule().As("E")
+				_    = E.Define(Or(
+					Con(E, Eq, E).NonAssoc().Prec(1),
+					Con(E, Plus, E).Left().Prec(2),
+					Con(E, Mult, E).Left().Prec(3),
+					Con(E, Pow, E).Right().Prec(4),
+					T,
+				))
+				P = Con(E, EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("precedence", func() {
+				testParse(s, P, TT{
+					tok("1", T),
+					tok("+", Plus),
+					tok("2", T),
+					tok("*", Mult),
+					tok("3", T),
+				}, `
+				P ::= E EOF
+					E ::= E + E
+						E ::= T
+							T ::= 1
+						+ ::= +
+						E ::= E * E
+							E ::= T
+								T ::= 2
+							* ::= *
+							E ::= T
+								T ::= 3
+					EOF ::= `)
+			})
+			testcase("left associativity", func() {
+				testParse(s, P, TT{
+					tok("1", T),
+					tok("+", Plus),
+					tok("2", T),
+					tok("+", Plus),
+					tok("3", T),
+				}, `
+				P ::= E EOF
+					E ::= E + E
+						E ::= E + E
+							E ::= T
+								T ::= 1
+							+ ::= +
+							E ::= T
+								T ::= 2
+						+ ::= +
+						E ::= T
+							T ::= 3
+					EOF ::= `)
+			})
+			testcase("right associativity", func() {
+				testParse(s, P, TT{
+					tok("1", T),
+					tok("^", Pow),
+					tok("2", T),
+					tok("^", Pow),
+					tok("3", T),
+				}, `
+				P ::= E EOF
+					E ::= E ^ E
+						E ::= T
+							T ::= 1
+						^ ::= ^
+						E ::= E ^ E
+							E ::= T
+								T ::= 2
+							^ ::= ^
+							E ::= T
+								T ::= 3
+					EOF ::= `)
+			})
+			testcase("non-associativity", func() {
+				expect := gspec.Expect(s.FailNow)
+				parser := parseAll(P, TT{
+					tok("1", T),
+					tok("=", Eq),
+					tok("2", T),
+					tok("=", Eq),
+					tok("3", T),
+				})
+				expect(len(parser.Results())).Equal(0)
+			})
+		})
+
+		given("a grammar with dangling else", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				If   = Term("if")
+				Else = Term("else")
+				X    = Term("x")
+				S    = NewRule().As("S")
+				_    = S.Define(Or(
+					Con(If, S).NotFollowedBy(Else),
+					Con(If, S, Else, S),
+					X,
+				))
+				P = Con(S, EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("else binds to the nearest if", func() {
+				testParse(s, P, TT{
+					tok("if", If),
+					tok("if", If),
+					tok("x", X),
+					tok("else", Else),
+					tok("x", X),
+				}, `
+				P ::= S EOF
+					S ::= if S
+						if ::= if
+						S ::= if S else S
+							if ::= if
+							S ::= x
+								x ::= x
+							else ::= else
+							S ::= x
+								x ::= x
+					EOF ::= `)
+			})
+		})
+
+		given("a grammar with a follow restriction", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				A  = Term("a")
+				B  = Term("b")
+				C  = Term("c")
+				AR = Con(A).As("A").NotFollowedBy(B)
+				P  = Con(AR, Or(B, C).As("BC"), EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("the rejected terminal is not expected", func() {
+				testParseError(s, P, false, TT{
+					tokAt("a", A, 0),
+					tokAt("b", B, 1),
+				}, "", `1: unexpected b, expected c`)
+			})
+			testcase("recovery", func() {
+				testParseError(s, P, true, TT{
+					tokAt("a", A, 0),
+					tokAt("b", B, 1),
+				}, `
+				P ::= A BC EOF
+					A ::= a
+					BC ::= c
+						c ::= 
+					EOF ::= `,
+					`1: unexpected b, expected c`,
+					`0: unexpected EOF, expected c`,
+				)
+			})
+		})
+
+		given("a grammar with reject rule", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				W    = Term("W")
+				Kw   = Con(W, W).As("Kw")
+				Name = W.Repeat(1, 3).As("Name").Reject(Kw)
+				P    = Con(Or(Name, Kw).As("S"), EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("not rejected", func() {
+				testParse(s, P, TT{
+					tok("a", W),
+					tok("b", W),
+					tok("c", W),
+				}, `
+				P ::= S EOF
+					S ::= Name
+						Name ::= W W W
+							W ::= a
+							W ::= b
+							W ::= c
+					EOF ::= `)
+			})
+			testcase("rejected", func() {
+				testParse(s, P, TT{
+					tok("a", W),
+					tok("b", W),
+				}, `
+				P ::= S EOF
+					S ::= Kw
+						Kw ::= W W
+							W ::= a
+							W ::= b
+					EOF ::= `)
+			})
 		})
 
 		given("a grammar with nullable rule", func() {
@@ -182,15 +501,105 @@ var _ = gspec.Add(func(s gspec.S) {
 			P ::= A XC EOF
 				A ::= A
 				XC ::= X C
-					X ::= B X
-						B ::= B
+					X ::= X B
 						X ::= B
 							B ::= B
+						B ::= B
 					C ::= C
 				EOF ::= `)
 			})
 		})
 
+		given("a grammar with empty rules", func() {
+			b := NewBuilder()
+			Term, Con, Or := b.Term, b.Con, b.Or
+			var (
+				A     = Term("A")
+				B     = Term("B")
+				C     = Term("C")
+				D     = Term("D")
+				Comma = Term(",")
+				P     = Con(A, B.Optional(), C.ZeroOrMore(), Or(D, Empty).As("D?"), EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("all empty", func() {
+				testParse(s, P, TT{
+					tok("A", A),
+				}, `
+				P ::= A B? C* D? EOF
+					A ::= A
+					B? ::= ε
+					C* ::= ε
+					D? ::= ε
+					EOF ::= `)
+			})
+			testcase("none empty", func() {
+				testParse(s, P, TT{
+					tok("A", A),
+					tok("B", B),
+					tok("C", C),
+					tok("C", C),
+					tok("D", D),
+				}, `
+				P ::= A B? C* D? EOF
+					A ::= A
+					B? ::= B
+						B ::= B
+					C* ::= C+
+						C+ ::= C+ C
+							C+ ::= C
+								C ::= C
+							C ::= C
+					D? ::= D
+						D ::= D
+					EOF ::= `)
+			})
+			testcase("unexpected token", func() {
+				testParseError(s, P, false, TT{
+					tokAt("A", A, 0),
+					tokAt("A", A, 1),
+				}, "", `1: unexpected A, expected B or C or D or EOF`)
+			})
+
+			L := Con(A.SepBy(Comma).As("L"), EOF).As("P")
+			L.InitTermSet()
+			testcase("separated by", func() {
+				testParse(s, L, TT{}, `
+				P ::= L EOF
+					L ::= ε
+					EOF ::= `)
+				testParse(s, L, TT{
+					tok("A", A),
+					tok(",", Comma),
+					tok("A", A),
+				}, `
+				P ::= L EOF
+					L ::= A (, A)*
+						A ::= A
+						(, A)* ::= (, A)+
+							(, A)+ ::= (, A)
+								(, A) ::= , A
+									, ::= ,
+									A ::= A
+					EOF ::= `)
+			})
+			E := Con(A.SepEndBy(Comma).As("L"), EOF).As("P")
+			E.InitTermSet()
+			testcase("separated and ended by", func() {
+				testParse(s, E, TT{
+					tok("A", A),
+					tok(",", Comma),
+				}, `
+				P ::= L EOF
+					L ::= A (, A)* ,?
+						A ::= A
+						(, A)* ::= ε
+						,? ::= ,
+							, ::= ,
+					EOF ::= `)
+			})
+		})
+
 		given("a grammar with common prefix", func() {
 			b := NewBuilder()
 			Term, Or, Con := b.Term, b.Or, b.Con
@@ -242,7 +651,37 @@ func testParse(s gspec.S, P *R, tokens TT, expected string) {
 	}
 	results := parser.Results()
 	expect(len(results)).Equal(1)
-	expect("\n" + results[0].String()).Equal(gspec.Unindent(expected))
+	expect(results[0].Ambiguities()).Equal([]Ambiguity(nil))
+	expect(results[0].String()).Equal(gspec.Unindent(expected) + "\n")
+}
+
+func parseAll(P *R, tokens TT) *Parser {
+	scanner := newTestScanner(append(tokens, tok("", EOF)))
+	parser := New(P)
+	for scanner.Scan() && parser.Parse(scanner.Token()) {
+	}
+	return parser
+}
+
+func testParseError(s gspec.S, P *R, recovery bool, tokens TT, expected string, errors ...string) {
+	expect := gspec.Expect(s.FailNow, 1)
+	scanner := newTestScanner(append(tokens, tok("", EOF)))
+	parser := New(P)
+	parser.SetRecovery(recovery)
+	for scanner.Scan() && parser.Parse(scanner.Token()) {
+	}
+	errs := make([]string, len(parser.Errors()))
+	for i, err := range parser.Errors() {
+		errs[i] = err.Error()
+	}
+	expect(errs).Equal(errors)
+	results := parser.Results()
+	if expected == "" {
+		expect(len(results)).Equal(0)
+		return
+	}
+	expect(len(results)).Equal(1)
+	expect(results[0].String()).Equal(gspec.Unindent(expected) + "\n")
 }
 
 type testToken struct {
@@ -254,3 +693,7 @@ type TT []*testToken
 func tok(v string, r *R) *testToken {
 	return &testToken{&Token{ID: 0, Value: []byte(v), Pos: 0}, r}
 }
+
+func tokAt(v string, r *R, pos int) *testToken {
+	return &testToken{&Token{ID: 0, Value: []byte(v), Pos: pos}, r}
+}
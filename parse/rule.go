@@ -20,13 +20,18 @@ Rule naming
 type (
 	// R is a BNF production rule
 	R struct {
-		name string
+		name    string
+		term    bool
+		nullAlt *Alt // the alternative deriving the empty string, nil if not nullable
+		list    list // r+ or r*, evaluated to a slice of values
 		Alts
 	}
 	Alt struct {
 		*R
 		Rules
 		termSet altSet
+		filter  *filter
+		action  Action
 	}
 	Rules   []*R
 	Alts    []*Alt
@@ -39,46 +44,104 @@ type (
 var (
 	EOF = newTerm().As("EOF")
 	SOF = newTerm().As("SOF")
+
+	// Empty is the rule matching the empty string, e.g. Or(x, Empty) is the
+	// same as x.Optional().
+	Empty = con()
 )
 
 func NewBuilder() *Builder {
 	return &Builder{terms: make(map[string]*R)}
 }
 
+// InitTermSet computes the nullable rules and the terminals that can begin each
+// alternative reachable from r. It must be called on the root rule before
+// parsing.
 func (r *R) InitTermSet() {
-	m := make(altSet)
-	r.eachAlt(func(alt *Alt) {
-		alt.initTermSet(m)
+	var rules Rules
+	r.eachReachable(make(map[*R]bool), func(r *R) {
+		rules = append(rules, r)
 	})
+	for changed := true; changed; {
+		changed = false
+		for _, r := range rules {
+			if r.nullAlt != nil || r.isTerm() {
+				continue
+			}
+			for _, a := range r.Alts {
+				if a.Rules.nullable() {
+					r.nullAlt = a
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, r := range rules {
+			for _, a := range r.Alts {
+				if a.initTermSet() {
+					changed = true
+				}
+			}
+		}
+	}
 }
 
-func (a *Alt) initTermSet(m map[*Alt]bool) altSet {
-	if m[a] {
-		return a.termSet
+func (r *R) eachReachable(m map[*R]bool, visit func(*R)) {
+	if m[r] {
+		return
+	}
+	m[r] = true
+	visit(r)
+	for _, a := range r.Alts {
+		for _, c := range a.Rules {
+			c.eachReachable(m, visit)
+		}
 	}
-	m[a] = true
-	if len(a.Rules) > 0 {
-		r0 := a.Rules[0]
-		if len(r0.Alts) == 1 {
-			a.termSet = r0.Alts[0].initTermSet(m)
-		} else {
-			r0.eachAlt(func(alt *Alt) {
-				for aa := range alt.initTermSet(m) {
-					a.termSet[aa] = true
+}
+
+// initTermSet adds the terminals that can begin a to its termSet, skipping the
+// nullable rules, and returns true if any terminal is added.
+func (a *Alt) initTermSet() (changed bool) {
+	for _, r := range a.Rules {
+		for _, alt := range r.Alts {
+			for t := range alt.termSet {
+				if !a.termSet[t] {
+					a.termSet[t] = true
+					changed = true
 				}
-			})
+			}
 		}
-		for i := 1; i < len(a.Rules); i++ {
-			a.Rules[i].eachAlt(func(alt *Alt) {
-				alt.initTermSet(m)
-			})
+		if r.nullAlt == nil {
+			break
 		}
 	}
-	return a.termSet
+	return
+}
+
+func (rs Rules) nullable() bool {
+	for _, r := range rs {
+		if r.nullAlt == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// emptyNode returns the node of the empty derivation of a nullable rule.
+func (r *R) emptyNode() *Node {
+	a := r.nullAlt
+	n := &Node{alt: a, values: make([]*Node, len(a.Rules))}
+	for i, c := range a.Rules {
+		n.values[i] = c.emptyNode()
+	}
+	return n
 }
 
 func newAlt(parent *R, rules Rules) *Alt {
-	a := &Alt{parent, rules, make(altSet)}
+	a := &Alt{R: parent, Rules: rules, termSet: make(altSet)}
 	//alt := &Alt{parent, rules, make(altSet)}
 	//alt.initTermSet()
 	return a
@@ -95,7 +158,7 @@ func newAlt(parent *R, rules Rules) *Alt {
 //}
 
 func newTerm() *R {
-	r := &R{}
+	r := &R{term: true}
 	alt := newAlt(r, nil)
 	alt.termSet = map[*Alt]bool{alt: true}
 	r.Alts = Alts{alt}
@@ -103,7 +166,7 @@ func newTerm() *R {
 }
 
 func (r *R) isTerm() bool {
-	return len(r.Alts) == 1 && len(r.Alts[0].Rules) == 0
+	return r.term
 }
 
 func NewRule() *R {
@@ -144,6 +207,14 @@ func (r *R) Define(o *R) *R {
 	return r
 }
 
+// Ref defines r as r ::= o. Unlike Define, the alternatives of o are not taken
+// over by r, so o can be a named rule, a terminal, a list or Empty that is also
+// referenced elsewhere.
+func (r *R) Ref(o *R) *R {
+	r.Alts = Alts{newAlt(r, Rules{o})}
+	return r
+}
+
 func (b *Builder) Con(rs ...interface{}) *R {
 	return con(b.toRules(rs)...)
 }
@@ -173,7 +244,10 @@ func or(rules ...*R) *R {
 }
 func (r *R) toAlt(parent *R) *Alt {
 	if len(r.Alts) == 1 && r.name == "" { // reduce unnamed rule
-		return newAlt(parent, r.Alts[0].Rules)
+		a := newAlt(parent, r.Alts[0].Rules)
+		a.filter = r.Alts[0].filter
+		a.action = r.Alts[0].action
+		return a
 	}
 	return newAlt(parent, Rules{r})
 }
@@ -199,8 +273,8 @@ func (r *R) AtLeast(n int) *R {
 
 func (r *R) Repeat(limit ...int) *R {
 	switch len(limit) {
-	//	case 0:
-	//		return r.zeroOrMore()
+	case 0:
+		return r.zeroOrMore()
 	case 1:
 		n := limit[0]
 		rs := make(Rules, n)
@@ -222,13 +296,50 @@ func (r *R) Repeat(limit ...int) *R {
 	panic("repeat should have zero to two arguments")
 }
 
+// oneOrMore returns r+ ::= r | r+ r. It is left recursive because a right
+// recursive list takes quadratic time to parse by Earley's algorithm. r is not
+// reduced into the alternatives even if it is unnamed, so that each item is a
+// child node, see EachItem.
 func (r *R) oneOrMore() *R {
 	x := NewRule()
-	x.Define(or(r, con(r, x)))
+	x.Alts = Alts{newAlt(x, Rules{r}), newAlt(x, Rules{x, r})}
 	x.As(parens(r.Name()) + "+")
+	x.list = oneOrMore
+	return x
+}
+
+func (r *R) zeroOrMore() *R {
+	x := NewRule()
+	x.Alts = Alts{r.oneOrMore().toAlt(x), newAlt(x, nil)}
+	x.As(parens(r.Name()) + "*")
+	x.list = zeroOrMore
+	return x
+}
+
+// ZeroOrMore returns a rule matching zero or more r, the same as Repeat().
+func (r *R) ZeroOrMore() *R {
+	return r.zeroOrMore()
+}
+
+// Optional returns a rule matching r or the empty string.
+func (r *R) Optional() *R {
+	x := NewRule()
+	x.Alts = Alts{r.toAlt(x), newAlt(x, nil)}
+	x.As(parens(r.Name()) + "?")
 	return x
 }
 
+// SepBy returns a rule matching zero or more r separated by sep.
+func (r *R) SepBy(sep *R) *R {
+	return con(r, con(sep, r).zeroOrMore()).Optional()
+}
+
+// SepEndBy returns a rule matching zero or more r separated and optionally
+// ended by sep.
+func (r *R) SepEndBy(sep *R) *R {
+	return con(r, con(sep, r).zeroOrMore(), sep.Optional()).Optional()
+}
+
 func (r *R) eachAlt(visit func(a *Alt)) {
 	for _, a := range r.Alts {
 		visit(a)
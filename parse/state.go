@@ -36,12 +36,7 @@ func (s *state) scan(t *Token) {
 func (s *state) advance(t *state) *state {
 	// copied because multiple alternatives shares the same parent
 	c := *s
-	if c.node == nil {
-		c.node = &Node{alt: s.Alt, values: make([]*Node, len(s.Alt.Rules))}
-	} else {
-		c.node = s.node.copy()
-	}
-	c.node.values[c.d] = t.node
+	c.node = s.node.extend(s.Alt, s.d, t.node)
 	c.d++
 	return &c
 }
@@ -50,10 +45,33 @@ type stateSet struct {
 	termAlt   *Alt
 	termState *state
 	m         map[*Alt]*state
+	advanced  map[stateKey]*advancedState
+	eval      bool    // evaluate the actions on completion
+	completed []*Node // the nodes evaluated within the set
+}
+
+// stateKey identifies an Earley item within a state set: the matchingRule
+// determines the alternative and the origin, d is the dot position.
+type stateKey struct {
+	*matchingRule
+	d int
+}
+
+// advancedState is a state advanced within a state set. The same state can be
+// reached from different parents (different splits of the same tokens) or by
+// different children (different alternatives of the same rule), and all of the
+// derivations are packed into its node instead of being parsed separately.
+type advancedState struct {
+	*state
+	families map[*Node][]*Node // families of the node indexed by the prefix
 }
 
 func newStateSet(ta *Alt) stateSet {
-	return stateSet{termAlt: ta, m: make(map[*Alt]*state)}
+	return stateSet{
+		termAlt:  ta,
+		m:        make(map[*Alt]*state),
+		advanced: make(map[stateKey]*advancedState),
+	}
 }
 
 func (ss *stateSet) add(alt *Alt, parent *state) (child *state, isNew bool) {
@@ -71,3 +89,48 @@ func (ss *stateSet) add(alt *Alt, parent *state) (child *state, isNew bool) {
 	child.parents = append(child.parents, parent)
 	return
 }
+
+// predicted returns true if s is predicted within the set, i.e. s completes
+// with an empty derivation, which has been handled by skipping the nullable
+// rule in predictNext.
+func (ss *stateSet) predicted(s *state) bool {
+	c, ok := ss.m[s.Alt]
+	return ok && c.matchingRule == s.matchingRule
+}
+
+// advance advances state s over the completed child t. If the resulting Earley
+// item already exists in the set, the new derivation is packed into its node
+// and isNew is false. a is nil if the derivation is rejected by filters.
+func (ss *stateSet) advance(s, t *state) (a *state, isNew bool) {
+	if !s.Alt.acceptsChild(s.d, t.Alt) {
+		return nil, false
+	}
+	key := stateKey{s.matchingRule, s.d + 1}
+	as, ok := ss.advanced[key]
+	if !ok {
+		a = s.advance(t)
+		ss.advanced[key] = &advancedState{
+			state:    a,
+			families: map[*Node][]*Node{s.node: a.node.families()},
+		}
+		return a, true
+	}
+	if fs, ok := as.families[s.node]; ok {
+		// same prefix, another derivation of the last child
+		for _, f := range fs {
+			f.values[s.d] = packNodes(f.values[s.d], t.node)
+		}
+		return as.state, false
+	}
+	// another prefix
+	n := as.node
+	if n.packed == nil {
+		// convert to an ambiguous node in place because it may have been
+		// referenced by the parents.
+		n.packed = []*Node{{alt: n.alt, values: n.values}}
+	}
+	fs := s.node.extend(s.Alt, s.d, t.node).families()
+	n.packed = append(n.packed, fs...)
+	as.families[s.node] = fs
+	return as.state, false
+}
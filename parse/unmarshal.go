@@ -0,0 +1,238 @@
+package parse
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+/*
+Struct binding
+
+Unmarshal binds a parse result to Go values by reflection, as an alternative
+to walking the Node tree by hand. A struct field is bound to the nodes of the
+rule named by its tag, e.g.
+
+	type Product struct {
+		Name    string   `gombi:"product-name"`
+		Version *Version `gombi:"product-version"`
+	}
+
+The nodes of a field are searched within the node of the struct, but not
+within a nested node of the same rule as the node of the struct, which is
+bound to its own struct, e.g. the nested comments of a comment. The first node
+found is bound to a field, or all of them to a slice field. A node of the
+repeated rule created by ZeroOrMore, Repeat() or AtLeast(1) is bound to a slice
+with one element for each repetition.
+
+A node is bound to a value according to the type of the value:
+1. Unmarshaler: UnmarshalNode is called.
+2. struct: the fields are bound as described above.
+3. pointer: a new value is allocated and bound.
+4. slice except []byte: the repetitions of the node are bound to the elements.
+5. string, []byte, bool, integers and floats: the concatenated token values of
+   the node are converted, and an error is reported as *UnmarshalError with the
+   position of the first token.
+
+The children of a node evaluated by an action are released during parsing, see
+action.go, so such a node is bound to the value of the action instead, which
+must be assignable to the Go value or to the element of a pointer. An
+ambiguous node is bound as its first derivation.
+*/
+
+// Unmarshaler is implemented by the types that can bind a node to themselves.
+type Unmarshaler interface {
+	UnmarshalNode(n *Node) error
+}
+
+// UnmarshalError describes a node that cannot be converted to a Go value.
+type UnmarshalError struct {
+	Pos   int          // the position of the first token of the node
+	Rule  *R           // the rule of the node
+	Value string       // the token values of the node
+	Type  reflect.Type // the type of the Go value
+	Err   error        // the conversion error, if any
+}
+
+func (e *UnmarshalError) Error() string {
+	s := fmt.Sprintf("%d: cannot unmarshal %s %q into Go value of type %s", e.Pos, e.Rule.Name(), e.Value, e.Type)
+	if e.Err != nil {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+// Unmarshal binds the node n to the value pointed to by v according to its
+// type and the field tags of the structs.
+func Unmarshal(n *Node, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("parse: Unmarshal(non-pointer %T)", v)
+	}
+	return unmarshal(n, rv.Elem())
+}
+
+// Unmarshal binds n to the value pointed to by v, the same as Unmarshal(n, v).
+func (n *Node) Unmarshal(v interface{}) error {
+	return Unmarshal(n, v)
+}
+
+var unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+
+func unmarshal(n *Node, v reflect.Value) error {
+	if v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		return v.Addr().Interface().(Unmarshaler).UnmarshalNode(n)
+	}
+	if n.released() {
+		return unmarshalValue(n, v)
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshal(n, v.Elem())
+	case reflect.Struct:
+		return unmarshalStruct(n, v)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes(n.text())
+			return nil
+		}
+		return unmarshalSlice(n.items(), v)
+	}
+	text := n.text()
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(string(text))
+		return nil
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(string(text)); err == nil {
+			v.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = strconv.ParseInt(string(text), 0, v.Type().Bits()); err == nil {
+			v.SetInt(i)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		if u, err = strconv.ParseUint(string(text), 0, v.Type().Bits()); err == nil {
+			v.SetUint(u)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(string(text), v.Type().Bits()); err == nil {
+			v.SetFloat(f)
+			return nil
+		}
+	}
+	if ne, ok := err.(*strconv.NumError); ok {
+		err = ne.Err
+	}
+	return &UnmarshalError{Pos: n.Pos(), Rule: n.Rule(), Value: string(text), Type: v.Type(), Err: err}
+}
+
+// unmarshalValue binds the value of the action of n, whose children have been
+// released.
+func unmarshalValue(n *Node, v reflect.Value) error {
+	rv := reflect.ValueOf(n.val)
+	switch {
+	case !rv.IsValid():
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	case rv.Type().AssignableTo(v.Type()):
+		v.Set(rv)
+		return nil
+	case v.Kind() == reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshalValue(n, v.Elem())
+	}
+	return &UnmarshalError{Pos: n.Pos(), Rule: n.Rule(), Type: v.Type(), Err: fmt.Errorf("the value of the action is %T", n.val)}
+}
+
+func unmarshalStruct(n *Node, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("gombi")
+		if name == "" || name == "-" || f.PkgPath != "" {
+			continue
+		}
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 &&
+			!reflect.PtrTo(fv.Type()).Implements(unmarshalerType) {
+			nodes := n.findAll(name)
+			if len(nodes) == 1 && nodes[0].alt.R.list != notList {
+				nodes = nodes[0].items()
+			}
+			if err := unmarshalSlice(nodes, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if nodes := n.findAll(name); len(nodes) > 0 {
+			if err := unmarshal(nodes[0], fv); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func unmarshalSlice(nodes []*Node, v reflect.Value) error {
+	if len(nodes) == 0 {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	s := reflect.MakeSlice(v.Type(), len(nodes), len(nodes))
+	for i, n := range nodes {
+		if err := unmarshal(n, s.Index(i)); err != nil {
+			return err
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+// findAll returns the descendant nodes of the rule name in depth-first order,
+// not searching within a matched node or a nested node of the same rule as n.
+func (n *Node) findAll(name string) (nodes []*Node) {
+	var walk func(*Node)
+	walk = func(c *Node) {
+		if c == nil {
+			return
+		}
+		if c.alt.R.name == name {
+			nodes = append(nodes, c)
+			return
+		}
+		if c.alt.R == n.alt.R {
+			return
+		}
+		for _, child := range c.values {
+			walk(child)
+		}
+	}
+	for _, child := range n.values {
+		walk(child)
+	}
+	return
+}
+
+// text returns the concatenated token values of n.
+func (n *Node) text() []byte {
+	var b bytes.Buffer
+	n.eachLeaf(func(leaf *Node) {
+		b.Write(leaf.token.Value)
+	})
+	return b.Bytes()
+}
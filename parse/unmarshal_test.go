@@ -0,0 +1,115 @@
+package parse
+
+import (
+	"h12.io/gspec"
+)
+
+type testUserAgent struct {
+	Products []*testProduct `gombi:"product"`
+	Comments []testComment  `gombi:"comment"`
+	Items    []string       `gombi:"user-agent"`
+}
+
+type testProduct struct {
+	Name    string  `gombi:"product-name"`
+	Version float64 `gombi:"product-version"`
+}
+
+type testComment struct {
+	Items    []string      `gombi:"ctext"`
+	Comments []testComment `gombi:"comment"`
+}
+
+var _ = gspec.Add(func(s gspec.S) {
+	describe, testcase, given := gspec.Alias3("describe", "testcase:", "given", s)
+
+	describe("the unmarshaler", func() {
+
+		given("a user agent grammar", func() {
+			b := NewBuilder()
+			Term, Or, Con := b.Term, b.Or, b.Con
+			var (
+				Token   = Term("token")
+				Slash   = Term("/")
+				LParen  = Term("(")
+				RParen  = Term(")")
+				Ctext   = Term("ctext")
+				Product = Con(NewRule().As("product-name").Ref(Token), Con(Slash, NewRule().As("product-version").Ref(Token)).Optional()).As("product")
+				Comment = NewRule().As("comment")
+				_       = Comment.Define(Con(LParen, Or(Ctext, Comment).ZeroOrMore(), RParen))
+				UA      = Or(Product, Comment).AtLeast(1).As("user-agent")
+				P       = Con(UA, EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("structs and slices", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{
+					tok("Mozilla", Token), tok("/", Slash), tok("5.0", Token),
+					tok("(", LParen), tok("X11", Ctext), tok("(", LParen), tok("nested", Ctext), tok(")", RParen), tok(")", RParen),
+					tok("Gecko", Token),
+				})
+				expect(p.Error()).Equal(nil)
+				var ua testUserAgent
+				expect(Unmarshal(p.Results()[0], &ua)).Equal(nil)
+				expect(ua).Equal(testUserAgent{
+					Products: []*testProduct{
+						{Name: "Mozilla", Version: 5},
+						{Name: "Gecko"},
+					},
+					Comments: []testComment{
+						{
+							Items: []string{"X11"},
+							Comments: []testComment{
+								{Items: []string{"nested"}},
+							},
+						},
+					},
+					Items: []string{"Mozilla/5.0", "(X11(nested))", "Gecko"},
+				})
+			})
+			testcase("conversion error", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{
+					tokAt("Mozilla", Token, 0), tokAt("/", Slash, 7), tokAt("x1", Token, 8),
+				})
+				var ua testUserAgent
+				err := p.Results()[0].Unmarshal(&ua)
+				expect(err).NotEqual(nil)
+				expect(err.Error()).Equal(`8: cannot unmarshal product-version "x1" into Go value of type float64: invalid syntax`)
+				expect(Unmarshal(p.Results()[0], ua).Error()).Equal("parse: Unmarshal(non-pointer parse.testUserAgent)")
+			})
+		})
+
+		given("a grammar with an action", func() {
+			b := NewBuilder()
+			Term, Con := b.Term, b.Con
+			var (
+				Word    = Term("word")
+				Slash   = Term("/")
+				Version = Con(Word, Slash, Word).As("version").Action(func(v []interface{}) interface{} {
+					return string(v[0].(*Token).Value) + "." + string(v[2].(*Token).Value)
+				})
+				P = Con(NewRule().As("name").Ref(Word), Version, EOF).As("P")
+			)
+			P.InitTermSet()
+			testcase("the value of the action", func() {
+				expect := gspec.Expect(s.FailNow)
+				p := parseAll(P, TT{tok("Gecko", Word), tok("1", Word), tok("/", Slash), tok("2", Word)})
+				expect(p.Results()[0].Child(1).ChildCount()).Equal(0)
+				var v struct {
+					Name    string  `gombi:"name"`
+					Version *string `gombi:"version"`
+				}
+				expect(Unmarshal(p.Results()[0], &v)).Equal(nil)
+				expect(v.Name).Equal("Gecko")
+				expect(*v.Version).Equal("1.2")
+				var w struct {
+					Version int `gombi:"version"`
+				}
+				err := Unmarshal(p.Results()[0], &w)
+				expect(err).NotEqual(nil)
+				expect(err.Error()).Equal(`0: cannot unmarshal version "" into Go value of type int: the value of the action is string`)
+			})
+		})
+	})
+})
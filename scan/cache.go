@@ -0,0 +1,59 @@
+package scan
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"reflect"
+)
+
+// CacheVar is a matcher to be written into a cache file as a package level
+// variable.
+type CacheVar struct {
+	Name    string
+	Matcher *Matcher
+	Goto    bool // written as a GotoMatcher by WriteGoto
+}
+
+// WriteCache writes a formatted Go source file of package pac, declaring each
+// of the matchers as a variable, so that a scanner can be shipped with
+// precompiled tables instead of building them at startup. A cached matcher
+// must be initialized with Init before use.
+func WriteCache(w io.Writer, pac string, vars []CacheVar) error {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gombi gen. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", pac)
+	fmt.Fprintln(&buf, "import (")
+	for _, v := range vars {
+		if !v.Goto || len(v.Matcher.Trails) > 0 {
+			fmt.Fprintln(&buf, `"h12.io/dfa"`)
+			break
+		}
+	}
+	fmt.Fprintln(&buf, `"h12.io/gombi/scan"`)
+	fmt.Fprintln(&buf, ")")
+	for _, v := range vars {
+		if v.Goto {
+			v.Matcher.WriteGoto(&buf, pac, v.Name)
+			continue
+		}
+		fmt.Fprintf(&buf, "var %s = ", v.Name)
+		v.Matcher.WriteGo(&buf, pac)
+		fmt.Fprintln(&buf)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// Equal returns true if m and o have the same tables and token IDs.
+func (m *Matcher) Equal(o *Matcher) bool {
+	return m.EOF == o.EOF && m.Illegal == o.Illegal &&
+		m.M.Start == o.M.Start && reflect.DeepEqual(m.M.States, o.M.States) &&
+		reflect.DeepEqual(m.Trails, o.Trails)
+}
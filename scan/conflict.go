@@ -0,0 +1,47 @@
+package scan
+
+import (
+	"h12.io/dfa"
+)
+
+// Conflict is a pair of tokens whose patterns can match the same string.
+type Conflict struct {
+	IDs     [2]int // the first one is listed first and wins in NewMatcher
+	Example string // one of the shortest strings matched by both
+}
+
+// Conflicts returns the pairs of token patterns in mids that can match the
+// same string, which NewMatcher resolves in favor of the one listed first.
+func Conflicts(mids []MID) (cs []Conflict) {
+	ms := make([]*dfa.M, len(mids))
+	for i, mid := range mids {
+		ms[i] = dfa.Or(toM(mid.M)).As(0) // a copy labeled the same as others
+	}
+	for i := range ms {
+		for j := i + 1; j < len(ms); j++ {
+			if s, ok := shortestMatch(dfa.And(ms[i], ms[j])); ok {
+				cs = append(cs, Conflict{IDs: [2]int{mids[i].ID, mids[j].ID}, Example: s})
+			}
+		}
+	}
+	return cs
+}
+
+// shortestMatch returns one of the shortest strings matched by m.
+func shortestMatch(m *dfa.M) (string, bool) {
+	fast := m.ToFast()
+	prefix := map[*dfa.FastS][]byte{&fast.States[0]: {}}
+	for queue := []*dfa.FastS{&fast.States[0]}; len(queue) > 0; queue = queue[1:] {
+		s := queue[0]
+		if s.Label >= 0 {
+			return string(prefix[s]), true
+		}
+		for b, next := range s.Trans {
+			if _, ok := prefix[next]; next != nil && !ok {
+				prefix[next] = append(append([]byte{}, prefix[s]...), byte(b))
+				queue = append(queue, next)
+			}
+		}
+	}
+	return "", false
+}
@@ -1,9 +1,30 @@
 package scan
 
-/*
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"h12.io/dfa"
+)
+
+// GotoMatcher is a Matcher compiled into Go code by WriteGoto, with a state
+// machine of goto statements instead of transition tables.
+type GotoMatcher struct {
+	EOF     int
+	Illegal int
+	Trails  []Trail
+
+	// Match returns the label and the end of the longest token starting at
+	// pos, and the position where the matching stopped. label is negative
+	// if no token is matched.
+	Match func(src []byte, pos int) (label, hi, end int)
+}
+
+// GotoScanner scans a source with a GotoMatcher, it behaves the same as
+// Scanner except that it does not read from an io.Reader.
 type GotoScanner struct {
-	Match        func(src []byte, pos int) (size, label int, matched bool)
-	EOF, Illegal int
+	*GotoMatcher
 
 	src []byte
 	p   int
@@ -12,31 +33,6 @@ type GotoScanner struct {
 	err error
 }
 
-func (s *GotoScanner) Scan() bool {
-	if s.err == io.EOF {
-		return false
-	}
-
-	s.tok.Pos = s.p
-	if s.p >= len(s.src) {
-		s.tok.ID = s.EOF
-		s.tok.Value = nil
-		s.err = io.EOF
-		return false
-	}
-	if size, label, matched := s.Match(s.src, s.p); matched {
-		s.tok.ID = label
-		s.tok.Value = s.src[s.p : s.p+size]
-		s.p += size
-		return true
-	}
-	s.tok.ID = s.Illegal
-	s.tok.Value = s.src[s.p : s.p+1] // advance 1 byte when illegal
-	s.err = invalidInputErr
-	s.p++
-	return false
-}
-
 func (s *GotoScanner) SetSource(src []byte) {
 	s.src = src
 	s.p = 0
@@ -44,18 +40,209 @@ func (s *GotoScanner) SetSource(src []byte) {
 	s.err = nil
 }
 
-func (s *GotoScanner) Pos() int {
-	return s.p
+func (s *GotoScanner) SetPos(p int) {
+	s.p = p
+}
+
+func (s *GotoScanner) Scan() bool {
+	label, hi, end := s.Match(s.src, s.p)
+	if label >= 0 {
+		if len(s.Trails) > 0 {
+			var size int
+			label, size = trail(s.Trails, label, s.src[s.p:hi])
+			hi = s.p + size
+		}
+		s.tok = Token{ID: label, Lo: s.p, Hi: hi}
+		s.p = hi
+		return true
+	} else if s.p == len(s.src) {
+		s.tok = Token{ID: s.EOF, Lo: s.p, Hi: s.p}
+		s.err = io.EOF
+		return true
+	}
+	s.tok = Token{ID: s.Illegal, Lo: s.p, Hi: end} // record the error position
+	s.err = invalidInputErr
+	s.p++ // advance 1 byte when illegal
+	return true
 }
 
 func (s *GotoScanner) Token() *Token {
 	return &s.tok
 }
 
+func (s *GotoScanner) Bytes() []byte {
+	return s.src[s.tok.Lo:s.tok.Hi]
+}
+
 func (s *GotoScanner) Error() error {
 	if s.err == io.EOF {
 		return nil
 	}
 	return s.err
 }
-*/
+
+// WriteGoto writes m as a GotoMatcher variable of package pac with a match
+// function named name+"Match", which has a labeled block of code per DFA
+// state and does a binary search on the byte ranges of its transitions.
+func (m *Matcher) WriteGoto(w io.Writer, pac, name string) {
+	prefix := "scan."
+	if pac == "scan" {
+		prefix = ""
+	}
+	fmt.Fprintf(w, "var %s = &%sGotoMatcher{\n", name, prefix)
+	fmt.Fprintf(w, "EOF: %d,\n", m.EOF)
+	fmt.Fprintf(w, "Illegal: %d,\n", m.Illegal)
+	writeTrails(w, pac, m.Trails)
+	fmt.Fprintf(w, "Match: %sMatch,\n", name)
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+
+	// a label not jumped to is a compile error
+	targeted := make([]bool, len(m.States))
+	for i := range m.States {
+		for _, t := range m.States[i].Table {
+			targeted[t.Next] = true
+		}
+	}
+	fast := m.M.ToFast()
+	fmt.Fprintf(w, "func %sMatch(src []byte, p int) (label, hi, end int) {\n", name)
+	fmt.Fprintln(w, "label = -1")
+	fmt.Fprintln(w, "var c byte")
+	for i := range m.States {
+		s := &m.States[i]
+		if targeted[i] {
+			fmt.Fprintf(w, "s%d:\n", i)
+		}
+		if l := fast.States[i].Label; l >= 0 {
+			fmt.Fprintf(w, "label, hi = %d, p\n", l)
+		}
+		if len(s.Table) > 0 {
+			fmt.Fprintln(w, "if p == len(src) {\nreturn label, hi, p\n}")
+			fmt.Fprintln(w, "c = src[p]")
+			writeSearch(w, s.Table)
+		}
+		fmt.Fprintln(w, "return label, hi, p")
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// writeSearch writes the transitions of a sorted table, each jumping to the
+// next state if matched and falling through otherwise.
+func writeSearch(w io.Writer, table dfa.TransTable) {
+	if len(table) > 4 {
+		mid := len(table) / 2
+		fmt.Fprintf(w, "if c < 0x%.2x {\n", table[mid].Lo)
+		writeSearch(w, table[:mid])
+		fmt.Fprintln(w, "return label, hi, p")
+		fmt.Fprintln(w, "}")
+		writeSearch(w, table[mid:])
+		return
+	}
+	for _, t := range table {
+		if t.Lo == t.Hi {
+			fmt.Fprintf(w, "if c == 0x%.2x {\n", t.Lo)
+		} else {
+			fmt.Fprintf(w, "if 0x%.2x <= c && c <= 0x%.2x {\n", t.Lo, t.Hi)
+		}
+		fmt.Fprintf(w, "p++\ngoto s%d\n}\n", t.Next)
+	}
+}
+
+// VerifyGoto checks that g matches the same as m. Every transition of m is
+// tested with an input that reaches it by a shortest path from the start
+// state and then continues by a shortest path to a labeled state if any.
+func (m *Matcher) VerifyGoto(g *GotoMatcher) error {
+	if m.EOF != g.EOF || m.Illegal != g.Illegal {
+		return fmt.Errorf("EOF or Illegal mismatch: %d, %d != %d, %d", g.EOF, g.Illegal, m.EOF, m.Illegal)
+	}
+	if !reflect.DeepEqual(g.Trails, m.Trails) {
+		return fmt.Errorf("trailing context mismatch")
+	}
+	fast := m.M.ToFast()
+	n := len(m.States)
+
+	// shortest paths from the start state
+	prefix := make([][]byte, n)
+	prefix[0] = []byte{}
+	for queue := []int{0}; len(queue) > 0; queue = queue[1:] {
+		i := queue[0]
+		for _, t := range m.States[i].Table {
+			if prefix[t.Next] == nil {
+				prefix[t.Next] = append(append([]byte{}, prefix[i]...), t.Lo)
+				queue = append(queue, t.Next)
+			}
+		}
+	}
+	// shortest paths to a labeled state, relaxed until no path gets shorter
+	suffix := make([][]byte, n)
+	for i := range suffix {
+		if fast.States[i].Label >= 0 {
+			suffix[i] = []byte{}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := range m.States {
+			for _, t := range m.States[i].Table {
+				if suffix[t.Next] != nil && (suffix[i] == nil || len(suffix[t.Next])+1 < len(suffix[i])) {
+					suffix[i] = append([]byte{t.Lo}, suffix[t.Next]...)
+					changed = true
+				}
+			}
+		}
+	}
+
+	check := func(src []byte) error {
+		label, hi, end := g.Match(src, 0)
+		wantLabel, wantHi, wantEnd := fastMatch(fast, src)
+		if label != wantLabel || hi != wantHi || end != wantEnd {
+			return fmt.Errorf("input %q: got (%d, %d, %d), want (%d, %d, %d)", src, label, hi, end, wantLabel, wantHi, wantEnd)
+		}
+		return nil
+	}
+	for i := range m.States {
+		if prefix[i] == nil {
+			continue // unreachable
+		}
+		if err := check(prefix[i]); err != nil {
+			return err
+		}
+		var covered [256]bool
+		for _, t := range m.States[i].Table {
+			for _, b := range []byte{t.Lo, t.Hi} {
+				covered[b] = true
+				src := append(append(append([]byte{}, prefix[i]...), b), suffix[t.Next]...)
+				if err := check(src); err != nil {
+					return err
+				}
+			}
+		}
+		for b := range covered {
+			if !covered[b] {
+				if err := check(append(append([]byte{}, prefix[i]...), byte(b))); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// fastMatch is the table driven counterpart of the match function written by
+// WriteGoto.
+func fastMatch(fast *dfa.FastM, src []byte) (label, hi, end int) {
+	label = -1
+	cur := &fast.States[0]
+	for p := 0; ; p++ {
+		if cur.Label >= 0 {
+			label, hi = cur.Label, p
+		}
+		if p == len(src) {
+			return label, hi, p
+		}
+		if cur = cur.Trans[src[p]]; cur == nil {
+			return label, hi, p
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package scan
+
+import (
+	"go/token"
+	"sort"
+	"unicode/utf8"
+)
+
+// Position is the line and column of an offset, all 1-based except Offset.
+type Position struct {
+	Offset     int
+	Line       int
+	Column     int // in bytes
+	RuneColumn int // in runes, an invalid UTF-8 byte counts as a rune
+}
+
+// Lines is a line table of a source maintained by a Scanner, see SetLines.
+// It records the offsets of the line starts and of the multi-byte runes, so
+// that the position of any scanned offset is known even if the bytes have
+// been dropped from the buffer of a Scanner reading from an io.Reader.
+type Lines struct {
+	File *token.File // if not nil, each line recorded is added to it
+
+	starts []int  // offsets of the line starts
+	wide   []wide // multi-byte runes
+	extra  int    // the number of bytes of the multi-byte runes beyond one
+	end    int    // the offset up to which the source has been recorded
+}
+type wide struct {
+	off   int
+	extra int // the number of extra bytes of the runes up to this one
+}
+
+// NewLines returns an empty line table, with each line added to file if
+// file is not nil.
+func NewLines(file *token.File) *Lines {
+	l := &Lines{File: file}
+	l.reset()
+	return l
+}
+
+func (l *Lines) reset() {
+	l.starts = append(l.starts[:0], 0)
+	l.wide = l.wide[:0]
+	l.extra = 0
+	l.end = 0
+}
+
+// Count returns the number of lines recorded so far.
+func (l *Lines) Count() int {
+	return len(l.starts)
+}
+
+// End returns the offset up to which the source has been recorded.
+func (l *Lines) End() int {
+	return l.end
+}
+
+// Position returns the position of an offset not after End.
+func (l *Lines) Position(off int) Position {
+	line := sort.SearchInts(l.starts, off+1)
+	start := l.starts[line-1]
+	col := off - start + 1
+	return Position{
+		Offset:     off,
+		Line:       line,
+		Column:     col,
+		RuneColumn: col - (l.extraBefore(off) - l.extraBefore(start)),
+	}
+}
+
+func (l *Lines) extraBefore(off int) int {
+	i := sort.Search(len(l.wide), func(i int) bool { return l.wide[i].off >= off })
+	if i == 0 {
+		return 0
+	}
+	return l.wide[i-1].extra
+}
+
+// add records the bytes of src that have not been recorded yet, until at
+// least the offset hi. src starts at offset off.
+func (l *Lines) add(src []byte, off, hi int) {
+	i := l.end - off
+	if i < 0 {
+		i = 0 // skipped by SetPos
+	}
+	for ; i < hi-off; i++ {
+		c := src[i]
+		if c == '\n' {
+			l.starts = append(l.starts, off+i+1)
+			if l.File != nil {
+				l.File.AddLine(off + i + 1)
+			}
+		} else if c >= utf8.RuneSelf {
+			// decode the whole rune even if it crosses hi
+			if _, size := utf8.DecodeRune(src[i:]); size > 1 {
+				l.extra += size - 1
+				l.wide = append(l.wide, wide{off: off + i, extra: l.extra})
+				i += size - 1
+			}
+		}
+	}
+	if off+i > l.end {
+		l.end = off + i
+	}
+}
+
+// SetLines makes the scanner record the lines of the source into l while
+// scanning. The table is reset whenever a source is set.
+func (s *Scanner) SetLines(l *Lines) {
+	s.lines = l
+	s.lines.reset()
+}
+
+// Position returns the position of an offset scanned so far, it is only
+// available after SetLines.
+func (s *Scanner) Position(off int) Position {
+	return s.lines.Position(off)
+}
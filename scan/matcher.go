@@ -11,6 +11,7 @@ type Matcher struct {
 	*dfa.M
 	EOF     int
 	Illegal int
+	Trails  []Trail // tokens with trailing context
 
 	fast *dfa.FastM
 }
@@ -19,13 +20,34 @@ type MID struct {
 	ID int
 }
 
+// Trail is a token pattern with trailing context, i.e. r/s in lex, see
+// FollowedBy.
+type Trail struct {
+	ID    int
+	Label int // the label of the pattern in the DFA, distinct from any ID
+	Head  *dfa.M
+	Tail  *dfa.M
+}
+
+// FollowedBy returns a pattern that matches head only when it is followed by
+// tail. Tail is counted in the length of the longest match, but is not a part
+// of the token and is scanned again as the start of the next token. Head and
+// tail can be either a string or a *dfa.M.
+func FollowedBy(head, tail interface{}) *Trail {
+	return &Trail{Head: toM(head), Tail: toM(tail)}
+}
+
+// NewMatcher returns a matcher of the token patterns in mids, each of which
+// is either a string, a *dfa.M or a *Trail. When more than one pattern
+// matches the longest token, the one listed first wins, see Conflicts.
 func NewMatcher(eof, illegal int, mids []MID) *Matcher {
-	m := or(mids)
+	m, trails := or(mids)
 	fast := m.ToFast()
 	return &Matcher{
 		EOF:     eof,
 		Illegal: illegal,
 		M:       m,
+		Trails:  trails,
 		fast:    fast}
 }
 
@@ -46,24 +68,90 @@ func (m *Matcher) WriteGo(w io.Writer, pac string) {
 	fmt.Fprintln(w, "&scan.Matcher{")
 	fmt.Fprintf(w, "EOF: %d,\n", m.EOF)
 	fmt.Fprintf(w, "Illegal: %d,\n", m.Illegal)
+	writeTrails(w, pac, m.Trails)
 	fmt.Fprint(w, "M: ")
 	m.M.WriteGo(w, pac)
 	fmt.Fprintln(w, "}")
 }
 
-func or(mids []MID) *dfa.M {
-	ms := make([]interface{}, len(mids))
+func writeTrails(w io.Writer, pac string, trails []Trail) {
+	if len(trails) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "Trails: []scan.Trail{")
+	for _, t := range trails {
+		fmt.Fprintf(w, "{ID: %d,\nLabel: %d,\nHead: ", t.ID, t.Label)
+		t.Head.WriteGo(w, pac)
+		fmt.Fprint(w, ",\nTail: ")
+		t.Tail.WriteGo(w, pac)
+		fmt.Fprintln(w, "},")
+	}
+	fmt.Fprintln(w, "},")
+}
+
+// trail returns the ID and the size of the head of a token with trailing
+// context, or the label and the size of the token itself if it has no
+// trailing context. The longest head is chosen if the token can be split in
+// more than one way.
+func trail(trails []Trail, label int, tok []byte) (id, size int) {
+	for _, t := range trails {
+		if t.Label != label {
+			continue
+		}
+		for n := len(tok); n >= 0; n-- {
+			if matchAll(t.Head, tok[:n]) && matchAll(t.Tail, tok[n:]) {
+				return t.ID, n
+			}
+		}
+	}
+	return label, len(tok)
+}
+
+func matchAll(m *dfa.M, s []byte) bool {
+	size, _, matched := m.Match(s)
+	return matched && size == len(s)
+}
+
+// or merges the patterns into one DFA, with each pattern excluding the
+// patterns before it so that the labels never conflict. A pattern with
+// trailing context is labeled after all the IDs.
+func or(mids []MID) (*dfa.M, []Trail) {
+	var (
+		ms     = make([]interface{}, len(mids))
+		prior  *dfa.M
+		trails []Trail
+		label  = 0
+	)
+	for _, mid := range mids {
+		if mid.ID >= label {
+			label = mid.ID + 1
+		}
+	}
 	for i, mid := range mids {
-		var m *dfa.M
-		switch o := mid.M.(type) {
-		case *dfa.M:
-			m = o
-		case string:
-			m = dfa.Str(o)
-		default:
-			panic("member M of MID should be type of either string or *M")
+		m, id := toM(mid.M), mid.ID
+		if t, ok := mid.M.(*Trail); ok {
+			trails = append(trails, Trail{ID: id, Label: label, Head: t.Head, Tail: t.Tail})
+			id = label
+			label++
+		}
+		if prior == nil {
+			prior = dfa.Or(m) // a copy not to be labeled
+		} else {
+			m, prior = m.Exclude(prior), dfa.Or(prior, m)
 		}
-		ms[i] = m.As(mid.ID)
+		ms[i] = m.As(id)
+	}
+	return dfa.Or(ms...), trails
+}
+
+func toM(o interface{}) *dfa.M {
+	switch o := o.(type) {
+	case *dfa.M:
+		return o
+	case string:
+		return dfa.Str(o)
+	case *Trail:
+		return dfa.Con(o.Head, o.Tail)
 	}
-	return dfa.Or(ms...)
+	panic("member M of MID should be type of either string, *M or *Trail")
 }
@@ -0,0 +1,122 @@
+package scan
+
+// Modes is a set of named lexer modes, similar to the start conditions of
+// flex. Each mode has its own matcher of the tokens active in it, and a
+// token scanned in a mode can switch to, push or pop a mode of the Scanner.
+type Modes struct {
+	start string
+	modes map[string]*mode
+}
+type mode struct {
+	name    string
+	matcher *Matcher
+	trans   map[int]Transition
+}
+
+// modeState is the current mode and the modes saved by Push.
+type modeState struct {
+	mode  *mode
+	stack []*mode
+}
+
+// Transition changes the mode of a Scanner after a token of ID is scanned.
+type Transition struct {
+	ID   int
+	Op   ModeOp
+	Mode string // the mode switched to or pushed, unused by Pop
+}
+
+type ModeOp int
+
+const (
+	SwitchMode ModeOp = iota // replace the current mode
+	PushMode                 // save the current mode and enter a new one
+	PopMode                  // return to the last saved mode
+)
+
+// Switch returns a transition that replaces the current mode with mode.
+func Switch(id int, mode string) Transition {
+	return Transition{ID: id, Op: SwitchMode, Mode: mode}
+}
+
+// Push returns a transition that enters mode and returns to the current mode
+// on a Pop.
+func Push(id int, mode string) Transition {
+	return Transition{ID: id, Op: PushMode, Mode: mode}
+}
+
+// Pop returns a transition that returns to the mode before the last Push. It
+// stays in the current mode if there is no mode pushed.
+func Pop(id int) Transition {
+	return Transition{ID: id, Op: PopMode}
+}
+
+// NewModes returns an empty set of modes, which starts in the mode named
+// start.
+func NewModes(start string) *Modes {
+	return &Modes{start: start, modes: make(map[string]*mode)}
+}
+
+// Add adds a mode with its matcher and transitions.
+func (ms *Modes) Add(name string, m *Matcher, trans ...Transition) *Modes {
+	md := &mode{name: name, matcher: m, trans: make(map[int]Transition)}
+	for _, t := range trans {
+		md.trans[t.ID] = t
+	}
+	ms.modes[name] = md
+	return ms
+}
+
+func (ms *Modes) get(name string) *mode {
+	md, ok := ms.modes[name]
+	if !ok {
+		panic("undefined lexer mode: " + name)
+	}
+	return md
+}
+
+// SetModes makes the scanner match with the matcher of the current mode, and
+// enter the start mode whenever a source is set. The modes are not tracked by
+// Tokens, so a moded source cannot be relexed.
+func (s *Scanner) SetModes(ms *Modes) {
+	s.modes = ms
+	s.enter(ms.get(ms.start))
+	s.stack = s.stack[:0]
+	s.last = modeState{s.mode, s.stack}
+}
+
+// Mode returns the name of the current mode, or an empty string if no modes
+// are set.
+func (s *Scanner) Mode() string {
+	if s.mode == nil {
+		return ""
+	}
+	return s.mode.name
+}
+
+func (s *Scanner) enter(md *mode) {
+	s.mode = md
+	s.Matcher = md.matcher
+	s.s0 = &s.fast.States[0]
+}
+
+// transit applies the transition of the current mode triggered by a token.
+func (s *Scanner) transit(id int) {
+	t, ok := s.mode.trans[id]
+	if !ok {
+		return
+	}
+	switch t.Op {
+	case SwitchMode:
+		s.enter(s.modes.get(t.Mode))
+	case PushMode:
+		// always copied, so that the stack saved in s.last is not modified
+		s.stack = append(s.stack[:len(s.stack):len(s.stack)], s.mode)
+		s.enter(s.modes.get(t.Mode))
+	case PopMode:
+		if n := len(s.stack); n > 0 {
+			s.enter(s.stack[n-1])
+			s.stack = s.stack[:n-1]
+		}
+	}
+}
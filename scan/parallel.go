@@ -0,0 +1,120 @@
+package scan
+
+import (
+	"sort"
+	"sync"
+)
+
+const (
+	// minChunkSize is the minimum number of bytes scanned by a worker of
+	// ScanAll.
+	minChunkSize = 16 * 1024
+	// bytesPerToken is the estimated average token size, used to allocate
+	// the token list at once rather than growing it, which triggers the
+	// garbage collector to scan the whole DFA over and over.
+	bytesPerToken = 4
+)
+
+// chunk is a part of a source scanned speculatively by a worker of ScanAll.
+type chunk struct {
+	lo, hi int     // the speculative start and the end of the chunk
+	toks   []Token // the tokens starting in [lo, hi)
+	next   int     // the start of the token after toks
+}
+
+// ScanAll scans the whole src into tokens ending with EOF, the same as
+// calling Scan until EOF, but splits src into chunks scanned concurrently by
+// up to workers goroutines. Each chunk except the first is scanned
+// speculatively as if a token started at its beginning. Since the DFA is
+// deterministic, a scan reaching the start of a speculative token produces
+// the same tokens from there on, so the chunks are stitched together at the
+// first token start they share, and only the bytes before it are scanned
+// again. The scanner is left at the end of src, and ScanAll falls back to a
+// sequential scan when lexer modes are set.
+func (s *Scanner) ScanAll(src []byte, workers int) []Token {
+	s.SetSource(src)
+	if n := len(src) / minChunkSize; workers > n {
+		workers = n
+	}
+	if workers <= 1 || s.modes != nil {
+		toks := make([]Token, 0, len(src)/bytesPerToken+1)
+		for s.Scan() {
+			toks = append(toks, s.tok)
+			if s.tok.ID == s.EOF {
+				break
+			}
+		}
+		return toks
+	}
+
+	chunks := make([]chunk, workers)
+	var wg sync.WaitGroup
+	for i := range chunks {
+		c := &chunks[i]
+		c.lo, c.hi = len(src)*i/workers, len(src)*(i+1)/workers
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.toks, c.next = s.scanChunk(src, c.lo, c.hi, nil)
+		}()
+	}
+	wg.Wait()
+
+	toks := make([]Token, 0, len(src)/bytesPerToken+1)
+	toks = append(toks, chunks[0].toks...)
+	next := chunks[0].next
+	for _, c := range chunks[1:] {
+		if next >= c.hi {
+			continue // a token spans the whole chunk
+		}
+		var stitched []Token
+		stitched, next = s.scanChunk(src, next, c.hi, &c)
+		toks = append(toks, stitched...)
+	}
+	toks = append(toks, Token{ID: s.EOF, Lo: len(src), Hi: len(src)})
+
+	if s.lines != nil {
+		s.lines.add(src, 0, len(src))
+	}
+	s.tok = toks[len(toks)-1]
+	s.end = len(src)
+	s.SetPos(len(src))
+	return toks
+}
+
+// start returns the index of the speculative token starting at p, or -1 if
+// there is none.
+func (c *chunk) start(p int) int {
+	i := sort.Search(len(c.toks), func(i int) bool { return c.toks[i].Lo >= p })
+	if i < len(c.toks) && c.toks[i].Lo == p {
+		return i
+	}
+	return -1
+}
+
+// scanChunk scans the tokens of src starting in [lo, hi), and returns them
+// with the start of the next token. If sync is not nil, the scan stops at the
+// first speculative token of sync and appends the rest of sync instead.
+func (s *Scanner) scanChunk(src []byte, lo, hi int, sync *chunk) (toks []Token, next int) {
+	if sync == nil && lo > 0 {
+		// a byte without a transition from the start state cannot begin
+		// a token, so the speculation starts at the first one that can.
+		for lo < hi && s.fast.States[0].Trans[src[lo]] == nil {
+			lo++
+		}
+	}
+	toks = make([]Token, 0, (hi-lo)/bytesPerToken+1)
+	c := Scanner{Matcher: s.Matcher}
+	c.SetSource(src)
+	c.SetPos(lo)
+	for c.p < hi {
+		if sync != nil {
+			if i := sync.start(c.p); i >= 0 {
+				return append(toks, sync.toks[i:]...), sync.next
+			}
+		}
+		c.scan()
+		toks = append(toks, c.tok)
+	}
+	return toks, c.p
+}
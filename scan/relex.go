@@ -0,0 +1,95 @@
+package scan
+
+import (
+	"sort"
+)
+
+// Edit replaces the bytes [Off, Off+Del) of a source with Ins.
+type Edit struct {
+	Off int    // the offset of the edit
+	Del int    // the number of bytes deleted
+	Ins []byte // the bytes inserted
+}
+
+// Apply returns a new source with the edit applied to src.
+func (e Edit) Apply(src []byte) []byte {
+	dst := make([]byte, 0, len(src)-e.Del+len(e.Ins))
+	dst = append(dst, src[:e.Off]...)
+	dst = append(dst, e.Ins...)
+	return append(dst, src[e.Off+e.Del:]...)
+}
+
+// Tokens is the token list of a whole source, which can be updated by Relex
+// after an edit instead of scanning the whole source again.
+type Tokens struct {
+	*Matcher
+	Src  []byte
+	List []Token // the tokens ending with EOF
+
+	ends []int // the positions where the scanning of the tokens stopped
+}
+
+// ScanTokens scans src into a token list.
+func ScanTokens(m *Matcher, src []byte) *Tokens {
+	t := &Tokens{Matcher: m}
+	t.Src = src
+	t.List, t.ends, _ = t.scan(0, -1, 0)
+	return t
+}
+
+// Relex applies the edit to the source and rescans only the tokens affected by
+// it, i.e. from the first token whose scanning reached the edit, until a
+// rescanned token starts where an old token starts after the edit. The old
+// tokens List[lo:oldHi] are replaced by the new tokens List[lo:newHi], and the
+// positions of the tokens after them are shifted.
+func (t *Tokens) Relex(e Edit) (lo, oldHi, newHi int) {
+	delta := len(e.Ins) - e.Del
+	for lo < len(t.ends)-1 && t.ends[lo] < e.Off { // EOF always reaches the edit
+		lo++
+	}
+	t.Src = e.Apply(t.Src)
+	list, ends, next := t.scan(t.List[lo].Lo, e.Off+len(e.Ins), delta)
+	oldHi = len(t.List)
+	if next >= 0 {
+		oldHi = sort.Search(len(t.List), func(i int) bool { return t.List[i].Lo >= next-delta })
+	}
+	newHi = lo + len(list)
+	tail := make([]Token, len(t.List)-oldHi)
+	tailEnds := make([]int, len(tail))
+	for i := range tail {
+		tok := t.List[oldHi+i]
+		tok.Lo += delta
+		tok.Hi += delta
+		tail[i] = tok
+		tailEnds[i] = t.ends[oldHi+i] + delta
+	}
+	t.List = append(append(t.List[:lo:lo], list...), tail...)
+	t.ends = append(append(t.ends[:lo:lo], ends...), tailEnds...)
+	return lo, oldHi, newHi
+}
+
+// scan scans the source from start until EOF, or until the next token starts at
+// or after sync in the new source where an old token starts, and returns the
+// position of the next token, or -1 if EOF is scanned.
+func (t *Tokens) scan(start, sync, delta int) (list []Token, ends []int, next int) {
+	s := Scanner{Matcher: t.Matcher}
+	s.SetSource(t.Src)
+	s.SetPos(start)
+	for {
+		if sync >= 0 && s.p >= sync && t.isOldStart(s.p-delta) {
+			return list, ends, s.p
+		}
+		s.Scan()
+		list = append(list, s.tok)
+		ends = append(ends, s.end)
+		if s.tok.ID == t.EOF {
+			return list, ends, -1
+		}
+	}
+}
+
+// isOldStart returns true if an old token starts at pos.
+func (t *Tokens) isOldStart(pos int) bool {
+	i := sort.Search(len(t.List), func(i int) bool { return t.List[i].Lo >= pos })
+	return i < len(t.List) && t.List[i].Lo == pos
+}
@@ -7,7 +7,27 @@ import (
 	"h12.io/dfa"
 )
 
-var invalidInputErr = errors.New("invalid input")
+var (
+	invalidInputErr = errors.New("invalid input")
+
+	// ErrTooLong is returned by Error when a token does not fit in the
+	// maximum buffer size of a Scanner reading from an io.Reader.
+	ErrTooLong = errors.New("token too long")
+	// ErrNoProgress is returned by Error when the io.Reader keeps returning
+	// no data and no error.
+	ErrNoProgress = errors.New("too many empty reads")
+)
+
+const (
+	// DefaultBufSize is the initial size of the sliding window used by
+	// SetReader.
+	DefaultBufSize = 64 * 1024
+	// MaxBufSize is the default maximum size of the sliding window, which
+	// also limits the size of a single token.
+	MaxBufSize = 1024 * 1024
+
+	maxEmptyReads = 100
+)
 
 type Scanner struct {
 	*Matcher
@@ -15,9 +35,23 @@ type Scanner struct {
 	s0  *dfa.FastS // start state cache
 	src []byte     // source buffer
 	p   int        // position in source buffer
+	off int        // absolute offset of src[0] in the stream
+
+	r      io.Reader // refills src when non-nil
+	buf    []byte    // initial buffer set by SetBuffer
+	maxBuf int       // maximum size of src when reading from r
+	rerr   error     // read error other than io.EOF
 
 	tok Token
+	end int // absolute position where the scanning of tok stopped
 	err error
+
+	modes *Modes    // lexer modes set by SetModes
+	mode  *mode     // the current mode
+	stack []*mode   // the modes saved by Push
+	last  modeState // the modes before the last token, restored by SetPos
+
+	lines *Lines // line table set by SetLines
 }
 type Token struct {
 	ID int
@@ -26,24 +60,96 @@ type Token struct {
 }
 
 func (s *Scanner) SetSource(src []byte) {
-	s.s0 = &s.fast.States[0]
+	s.reset()
 	s.src = src
+}
+
+// SetReader makes the scanner read its input from r through a sliding
+// window instead of a whole []byte. Token.Lo and Token.Hi are absolute
+// offsets in the stream. A token must fit in the maximum buffer size, see
+// SetBuffer. When r returns an error other than io.EOF, the tokens of the
+// bytes read before it are still scanned, and Scan returns false with the
+// error only when they are exhausted.
+func (s *Scanner) SetReader(r io.Reader) {
+	s.reset()
+	if s.buf == nil {
+		s.buf = make([]byte, DefaultBufSize)
+	}
+	if s.maxBuf < cap(s.buf) {
+		s.maxBuf = MaxBufSize
+		if s.maxBuf < cap(s.buf) {
+			s.maxBuf = cap(s.buf)
+		}
+	}
+	s.src = s.buf[:0]
+	s.r = r
+}
+
+// SetBuffer sets the initial buffer and the maximum buffer size used by
+// SetReader, similar to bufio.Scanner.Buffer, the maximum is at least
+// cap(buf). It must be called before SetReader.
+//
+// The buffer keeps the last scanned token while scanning the next one so that
+// SetPos can step back to it, so the bytes from the start of the last token to
+// the end of the next one, plus the byte after it where the matching stops,
+// must fit in the maximum, otherwise Scan stops with ErrTooLong.
+func (s *Scanner) SetBuffer(buf []byte, max int) {
+	s.buf = buf[:cap(buf)]
+	if max < cap(buf) {
+		max = cap(buf)
+	}
+	s.maxBuf = max
+}
+
+func (s *Scanner) reset() {
+	if s.modes != nil {
+		s.SetModes(s.modes)
+	}
+	if s.lines != nil {
+		s.lines.reset()
+	}
+	s.s0 = &s.fast.States[0]
+	s.src = nil
 	s.p = 0
+	s.off = 0
+	s.r = nil
+	s.rerr = nil
 	s.tok = Token{}
+	s.end = 0
 	s.err = nil
 }
 
+// SetPos sets the absolute position of the next token. When reading from an
+// io.Reader, p must not be before the start of the last scanned token. With
+// lexer modes, setting the position back to the start of the last token also
+// restores the modes before it, so that it is scanned again in the same mode,
+// while the modes are unchanged for any other position.
 func (s *Scanner) SetPos(p int) {
-	s.p = p
+	if s.mode != nil && p == s.tok.Lo {
+		s.enter(s.last.mode)
+		s.stack = s.last.stack
+	}
+	s.p = p - s.off
 }
 
 func (s *Scanner) Scan() bool {
+	ok := s.scan()
+	if s.lines != nil {
+		s.lines.add(s.src, s.off, s.off+s.p)
+	}
+	return ok
+}
+
+func (s *Scanner) scan() bool {
 	var (
 		matched    bool
 		pos        = s.p
 		matchedPos = s.p
 		cur        = s.s0
 	)
+	if s.mode != nil {
+		s.last = modeState{s.mode, s.stack}
+	}
 	for {
 		if cur.Label >= 0 {
 			s.tok.ID = cur.Label
@@ -51,7 +157,15 @@ func (s *Scanner) Scan() bool {
 			matched = true
 		}
 		if pos == len(s.src) {
-			break
+			if s.r == nil {
+				break
+			}
+			shift := s.fill()
+			pos -= shift
+			matchedPos -= shift
+			if pos == len(s.src) {
+				break
+			}
 		}
 		b := s.src[pos]
 		if cur = cur.Trans[b]; cur == nil {
@@ -59,30 +173,116 @@ func (s *Scanner) Scan() bool {
 		}
 		pos++
 	}
+	s.end = s.off + pos
+	if s.rerr == ErrTooLong {
+		// the token is cut, unlike the last one before a read error
+		s.err = s.rerr
+		return false
+	}
 	if matched {
-		s.tok.Lo = s.p
-		s.tok.Hi = matchedPos
+		if len(s.Trails) > 0 {
+			var size int
+			s.tok.ID, size = trail(s.Trails, s.tok.ID, s.src[s.p:matchedPos])
+			matchedPos = s.p + size
+		}
+		s.tok.Lo = s.off + s.p
+		s.tok.Hi = s.off + matchedPos
 		s.p = matchedPos
+		if s.mode != nil {
+			s.transit(s.tok.ID)
+		}
 		return true
 	} else if s.p == len(s.src) {
+		if s.rerr != nil {
+			s.err = s.rerr
+			return false
+		}
 		s.tok.ID = s.EOF
-		s.tok.Lo = s.p
-		s.tok.Hi = s.p
+		s.tok.Lo = s.off + s.p
+		s.tok.Hi = s.tok.Lo
 		s.err = io.EOF
 		return true
 	}
 	s.tok.ID = s.Illegal
-	s.tok.Lo = s.p
-	s.tok.Hi = pos // record the error position
+	s.tok.Lo = s.off + s.p
+	s.tok.Hi = s.off + pos // record the error position
 	s.err = invalidInputErr
 	s.p++ // advance 1 byte when illegal
 	return true
 }
 
+// fill slides the window so that the current token starts at the beginning
+// of the buffer, reads more data from the reader and returns the number of
+// bytes the window has been shifted by.
+func (s *Scanner) fill() (shift int) {
+	// keep the last scanned token so that SetPos can step back to it.
+	if shift = s.tok.Lo - s.off; shift > s.p {
+		shift = s.p
+	}
+	if shift > 0 {
+		n := copy(s.src[:cap(s.src)], s.src[shift:])
+		s.src = s.src[:n]
+		s.off += shift
+		s.p -= shift
+	} else {
+		shift = 0
+	}
+	if len(s.src) == cap(s.src) {
+		if cap(s.src) >= s.maxBuf {
+			s.rerr = ErrTooLong
+			s.r = nil
+			return
+		}
+		size := cap(s.src) * 2
+		if size == 0 {
+			size = DefaultBufSize
+		}
+		if size > s.maxBuf {
+			size = s.maxBuf
+		}
+		buf := make([]byte, len(s.src), size)
+		copy(buf, s.src)
+		s.src = buf
+	}
+	for i := 0; i < maxEmptyReads; i++ {
+		n, err := s.r.Read(s.src[len(s.src):cap(s.src)])
+		s.src = s.src[:len(s.src)+n]
+		if err != nil {
+			if err != io.EOF {
+				s.rerr = err
+			}
+			s.r = nil // the rest is scanned as an in-memory source
+			return
+		}
+		if n > 0 {
+			return
+		}
+	}
+	s.rerr = ErrNoProgress
+	s.r = nil
+	return
+}
+
 func (s *Scanner) Token() *Token {
 	return &s.tok
 }
 
+// Bytes returns the bytes of the current token. The slice may be overwritten
+// by the next call to Scan when reading from an io.Reader.
+func (s *Scanner) Bytes() []byte {
+	return s.src[s.tok.Lo-s.off : s.tok.Hi-s.off]
+}
+
+// Slice returns the bytes [lo, hi) of the source, hi is truncated to the end
+// of the bytes read so far. When reading from an io.Reader, lo must not be
+// before the start of the current token.
+func (s *Scanner) Slice(lo, hi int) []byte {
+	if hi > s.off+len(s.src) {
+		hi = s.off + len(s.src)
+	}
+	return s.src[lo-s.off : hi-s.off]
+}
+
 func (s *Scanner) Error() error {
 	if s.err == io.EOF {
 		return nil
@@ -0,0 +1,477 @@
+package scan
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/token"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+
+	"h12.io/gspec"
+)
+
+const (
+	tEOF = iota
+	tIllegal
+	tIdent
+	tInt
+	tSpace
+	tString
+)
+
+func testMatcher() *Matcher {
+	var (
+		c  = Char
+		b  = Between
+		s  = Str
+		or = Or
+
+		letter = or(b('a', 'z'), b('A', 'Z'), c(`_`))
+		digit  = b('0', '9')
+	)
+	return NewMatcher(tEOF, tIllegal, []MID{
+		{Con(letter, or(letter, digit).Repeat()), tIdent},
+		{digit.AtLeast(1), tInt},
+		{c(" \t\n").AtLeast(1), tSpace},
+		{Con(s(`"`), b(1, 0x7f).Exclude(s(`"`)).Repeat(), s(`"`)), tString},
+	})
+}
+
+type testToken struct {
+	Token
+	Value string
+}
+
+func scanAll(s *Scanner) (toks []testToken) {
+	for s.Scan() {
+		toks = append(toks, testToken{*s.Token(), string(s.Bytes())})
+		if s.Token().ID == tEOF {
+			break
+		}
+	}
+	return
+}
+
+func testSource() []byte {
+	var w bytes.Buffer
+	for i := 0; i < 500; i++ {
+		w.WriteString("abc 123 \"")
+		w.WriteString(strings.Repeat("x", i))
+		w.WriteString("\"\t$x_1\n")
+	}
+	return w.Bytes()
+}
+
+func TestReader(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := testMatcher()
+	src := testSource()
+
+	s := Scanner{Matcher: m}
+	s.SetSource(src)
+	want := scanAll(&s)
+
+	for _, size := range []int{1, 7, 64, 4096} {
+		s := Scanner{Matcher: m}
+		s.SetBuffer(make([]byte, size), 1024)
+		s.SetReader(iotest.HalfReader(bytes.NewReader(src)))
+		expect(scanAll(&s)).Equal(want)
+		expect(s.Error()).Equal(nil)
+	}
+}
+
+func TestReaderSetPos(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	s := Scanner{Matcher: testMatcher()}
+	s.SetBuffer(make([]byte, 4), 64)
+	s.SetReader(iotest.OneByteReader(strings.NewReader("abcdef 123456")))
+	s.Scan()
+	s.Scan()
+	expect(string(s.Bytes())).Equal(" ")
+	s.SetPos(0)
+	s.Scan()
+	expect(*s.Token()).Equal(Token{ID: tIdent, Lo: 0, Hi: 6})
+	expect(string(s.Bytes())).Equal("abcdef")
+}
+
+func TestReaderTooLong(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	s := Scanner{Matcher: testMatcher()}
+	s.SetBuffer(make([]byte, 4), 16)
+	s.SetReader(strings.NewReader("abc " + strings.Repeat("x", 32)))
+	expect(s.Scan()).Equal(true)
+	expect(s.Scan()).Equal(true)
+	expect(s.Scan()).Equal(false)
+	expect(s.Error()).Equal(ErrTooLong)
+}
+
+// dataErrReader returns all its data together with an error.
+type dataErrReader struct {
+	data string
+	err  error
+}
+
+func (r *dataErrReader) Read(p []byte) (int, error) {
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, r.err
+}
+
+func TestReaderError(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	readErr := errors.New("read error")
+	s := Scanner{Matcher: testMatcher()}
+	s.SetReader(&dataErrReader{"abc def", readErr})
+	expect(scanAll(&s)).Equal([]testToken{
+		{Token{tIdent, 0, 3}, "abc"},
+		{Token{tSpace, 3, 4}, " "},
+		{Token{tIdent, 4, 7}, "def"},
+	})
+	expect(s.Error()).Equal(readErr)
+}
+
+func TestSetBufferMax(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	s := Scanner{Matcher: testMatcher()}
+	s.SetBuffer(make([]byte, 8), 4)
+	s.SetReader(strings.NewReader("abcdefg " + strings.Repeat("x", 16)))
+	expect(s.Scan()).Equal(true)
+	expect(string(s.Bytes())).Equal("abcdefg")
+	// the last token is kept while scanning the next one
+	expect(s.Scan()).Equal(false)
+	expect(s.Error()).Equal(ErrTooLong)
+}
+
+func BenchmarkScan(b *testing.B) {
+	src := testSource()
+	s := Scanner{Matcher: testMatcher()}
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		s.SetSource(src)
+		for s.Scan() && s.Token().ID != tEOF {
+		}
+	}
+}
+
+func BenchmarkScanReader(b *testing.B) {
+	src := testSource()
+	s := Scanner{Matcher: testMatcher()}
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		s.SetReader(bytes.NewReader(src))
+		for s.Scan() && s.Token().ID != tEOF {
+		}
+	}
+}
+
+func scanTokens(m *Matcher, src []byte) (toks []Token) {
+	s := Scanner{Matcher: m}
+	s.SetSource(src)
+	for s.Scan() {
+		toks = append(toks, *s.Token())
+		if s.Token().ID == tEOF {
+			break
+		}
+	}
+	return
+}
+
+func TestScanAll(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := testMatcher()
+	rnd := rand.New(rand.NewSource(1))
+	random := make([]byte, 8*minChunkSize+3)
+	for i := range random {
+		random[i] = "ab1 \"$\n"[rnd.Intn(7)]
+	}
+	long := append([]byte(`x "`), bytes.Repeat([]byte("y"), 3*minChunkSize)...)
+	long = append(long, `" z`...)
+	for _, src := range [][]byte{testSource(), random, long, []byte("abc 123")} {
+		want := scanTokens(m, src)
+		for _, workers := range []int{1, 2, 3, 8, 100} {
+			s := Scanner{Matcher: m}
+			expect(s.ScanAll(src, workers)).Equal(want)
+			expect(s.Scan()).Equal(true)
+			expect(*s.Token()).Equal(Token{ID: tEOF, Lo: len(src), Hi: len(src)})
+		}
+	}
+}
+
+func TestRelex(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := testMatcher()
+	rnd := rand.New(rand.NewSource(1))
+	src := testSource()[:2000]
+	toks := ScanTokens(m, src)
+	for i := 0; i < 500; i++ {
+		off := rnd.Intn(len(toks.Src) + 1)
+		del := rnd.Intn(len(toks.Src)-off+1) % 8
+		ins := []byte(`a1 "$`[rnd.Intn(5):][:rnd.Intn(2)])
+		ins = append(ins, `x "`[rnd.Intn(3):]...)
+		e := Edit{Off: off, Del: del, Ins: ins}
+		old := append([]Token(nil), toks.List...)
+		lo, oldHi, newHi := toks.Relex(e)
+		want := ScanTokens(m, toks.Src)
+		expect(toks.List).Equal(want.List)
+		expect(toks.ends).Equal(want.ends)
+		expect(old[:lo]).Equal(toks.List[:lo])
+		expect(len(old) - oldHi).Equal(len(toks.List) - newHi)
+	}
+}
+
+func TestRelexSync(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	toks := ScanTokens(testMatcher(), []byte("abc 123 def 456"))
+	lo, oldHi, newHi := toks.Relex(Edit{Off: 5, Del: 1, Ins: []byte("99")})
+	expect(string(toks.Src)).Equal("abc 1993 def 456")
+	expect([]int{lo, oldHi, newHi}).Equal([]int{2, 3, 3})
+	expect(toks.List[2]).Equal(Token{ID: tInt, Lo: 4, Hi: 8})
+	expect(toks.List[4]).Equal(Token{ID: tIdent, Lo: 9, Hi: 12})
+}
+
+func TestWriteCache(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := testMatcher()
+	expect(m.Equal(testMatcher())).Equal(true)
+	var w bytes.Buffer
+	expect(WriteCache(&w, "foo", []CacheVar{{Name: "fooCache", Matcher: m}})).Equal(nil)
+	src := w.String()
+	expect(strings.HasPrefix(src, "// Code generated by gombi gen. DO NOT EDIT.\n\npackage foo\n")).Equal(true)
+	expect(strings.Contains(src, "var fooCache = &scan.Matcher{\n\tEOF:     0,\n\tIllegal: 1,\n")).Equal(true)
+
+	o := testMatcher()
+	o.States[0].Table[0].Next = 0
+	expect(m.Equal(o)).Equal(false)
+}
+
+func TestWriteGoto(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := testMatcher()
+	var w bytes.Buffer
+	expect(WriteCache(&w, "foo", []CacheVar{{Name: "fooGoto", Matcher: m, Goto: true}})).Equal(nil)
+	src := w.String()
+	expect(strings.Contains(src, "var fooGoto = &scan.GotoMatcher{")).Equal(true)
+	expect(strings.Contains(src, "func fooGotoMatch(src []byte, p int) (label, hi, end int) {")).Equal(true)
+
+	fast := m.ToFast()
+	g := &GotoMatcher{EOF: tEOF, Illegal: tIllegal, Match: func(src []byte, p int) (label, hi, end int) {
+		label, hi, end = fastMatch(fast, src[p:])
+		return label, p + hi, p + end
+	}}
+	expect(m.VerifyGoto(g)).Equal(nil)
+	s := GotoScanner{GotoMatcher: g}
+	s.SetSource(testSource()[:2000])
+	var toks []Token
+	for s.Scan() && s.Token().ID != tEOF {
+		toks = append(toks, *s.Token())
+	}
+	expect(toks).Equal(ScanTokens(m, testSource()[:2000]).List[:len(toks)])
+
+	g.Match = func(src []byte, p int) (label, hi, end int) {
+		label, hi, end = fastMatch(fast, src[p:])
+		if label == tInt {
+			label = tIdent
+		}
+		return label, p + hi, p + end
+	}
+	expect(m.VerifyGoto(g) != nil).Equal(true)
+}
+
+const (
+	tKeyword = tString + 1 + iota
+	tFloat
+	tRange
+)
+
+func rangeMIDs() []MID {
+	var (
+		b      = Between
+		con    = Con
+		or     = Or
+		digits = b('0', '9').AtLeast(1)
+		letter = or(b('a', 'z'), b('A', 'Z'))
+	)
+	return []MID{
+		{or("for", "in"), tKeyword},
+		{con(letter, or(letter, b('0', '9')).Repeat()), tIdent},
+		{digits, tInt},
+		{con(digits, ".", digits), tFloat},
+		{FollowedBy(con(digits, "."), b(0, 0x7f).Exclude(".")), tFloat},
+		{"..", tRange},
+		{Char(" "), tSpace},
+	}
+}
+
+func scanIDs(m *Matcher, src string) (toks []string) {
+	s := Scanner{Matcher: m}
+	s.SetSource([]byte(src))
+	for s.Scan() && s.Token().ID != tEOF {
+		toks = append(toks, tok(s.Token().ID, string(s.Bytes())))
+	}
+	return
+}
+
+func tok(id int, s string) string {
+	return fmt.Sprintf("%d:%s", id, s)
+}
+
+func TestPriority(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := NewMatcher(tEOF, tIllegal, rangeMIDs())
+	expect(scanIDs(m, "for x in forx")).Equal([]string{
+		tok(tKeyword, "for"), tok(tSpace, " "), tok(tIdent, "x"), tok(tSpace, " "),
+		tok(tKeyword, "in"), tok(tSpace, " "), tok(tIdent, "forx")})
+}
+
+func TestTrailingContext(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := NewMatcher(tEOF, tIllegal, rangeMIDs())
+	expect(scanIDs(m, "1..2 1.5 1. x")).Equal([]string{
+		tok(tInt, "1"), tok(tRange, ".."), tok(tInt, "2"), tok(tSpace, " "),
+		tok(tFloat, "1.5"), tok(tSpace, " "),
+		tok(tFloat, "1."), tok(tSpace, " "), tok(tIdent, "x")})
+
+	var w bytes.Buffer
+	expect(WriteCache(&w, "foo", []CacheVar{{Name: "fooCache", Matcher: m}})).Equal(nil)
+	expect(strings.Contains(w.String(), "Trails: []scan.Trail{")).Equal(true)
+}
+
+func TestConflicts(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	expect(Conflicts(rangeMIDs())).Equal([]Conflict{
+		{IDs: [2]int{tKeyword, tIdent}, Example: "in"},
+		{IDs: [2]int{tFloat, tFloat}, Example: "0.0"},
+	})
+}
+
+const (
+	tQuote = tString + 1 + iota
+	tText
+	tInterp
+	tRightBrace
+)
+
+func TestModes(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	var (
+		b      = Between
+		or     = Or
+		letter = or(b('a', 'z'), b('A', 'Z'))
+	)
+	code := NewMatcher(tEOF, tIllegal, []MID{
+		{letter.AtLeast(1), tIdent},
+		{Char(" "), tSpace},
+		{`"`, tQuote},
+		{`}`, tRightBrace},
+	})
+	str := NewMatcher(tEOF, tIllegal, []MID{
+		{b(' ', '~').Exclude(`"`, `$`).AtLeast(1), tText},
+		{`${`, tInterp},
+		{`"`, tQuote},
+	})
+	modes := NewModes("code").
+		Add("code", code, Push(tQuote, "str"), Pop(tRightBrace)).
+		Add("str", str, Push(tInterp, "code"), Pop(tQuote))
+	s := Scanner{}
+	s.SetModes(modes)
+	s.SetSource([]byte(`a "b ${c "d"} e" f}`))
+	var toks, modeNames []string
+	for s.Scan() && s.Token().ID != tEOF {
+		toks = append(toks, tok(s.Token().ID, string(s.Bytes())))
+		modeNames = append(modeNames, s.Mode())
+	}
+	expect(toks).Equal([]string{
+		tok(tIdent, "a"), tok(tSpace, " "), tok(tQuote, `"`),
+		tok(tText, "b "), tok(tInterp, "${"),
+		tok(tIdent, "c"), tok(tSpace, " "), tok(tQuote, `"`), tok(tText, "d"), tok(tQuote, `"`),
+		tok(tRightBrace, "}"), tok(tText, " e"), tok(tQuote, `"`),
+		tok(tSpace, " "), tok(tIdent, "f"), tok(tRightBrace, "}")})
+	expect(modeNames).Equal([]string{
+		"code", "code", "str",
+		"str", "code",
+		"code", "code", "str", "str", "code",
+		"str", "str", "code",
+		"code", "code", "code"})
+
+	s.SetSource([]byte(`"x`))
+	expect(s.Mode()).Equal("code")
+
+	// stepping back to the last token restores its mode
+	s.SetSource([]byte(`a "${b}"`))
+	for s.Scan() && s.Token().ID != tInterp {
+	}
+	expect(s.Mode()).Equal("code")
+	s.SetPos(s.Token().Lo)
+	expect(s.Mode()).Equal("str")
+	for s.Scan() && s.Token().ID != tRightBrace {
+	}
+	expect(s.Mode()).Equal("str")
+	s.SetPos(s.Token().Lo)
+	expect(s.Mode()).Equal("code")
+	s.Scan()
+	expect(tok(s.Token().ID, string(s.Bytes()))).Equal(tok(tRightBrace, "}"))
+	expect(s.Mode()).Equal("str")
+	s.Scan()
+	expect(tok(s.Token().ID, string(s.Bytes()))).Equal(tok(tQuote, `"`))
+	expect(s.Mode()).Equal("code")
+}
+
+func TestLines(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	m := NewMatcher(tEOF, tIllegal, []MID{
+		{Between('!', utf8.MaxRune).AtLeast(1), tIdent},
+		{Char(" \t\n").AtLeast(1), tSpace},
+	})
+	src := []byte("ab  世界 c\n\nαβγ d\t\n e 世\nf")
+	position := func(off int) Position {
+		start := bytes.LastIndexByte(src[:off], '\n') + 1
+		return Position{
+			Offset:     off,
+			Line:       bytes.Count(src[:off], []byte("\n")) + 1,
+			Column:     off - start + 1,
+			RuneColumn: utf8.RuneCount(src[start:off]) + 1,
+		}
+	}
+	for _, size := range []int{0, 1, 4} {
+		fset := token.NewFileSet()
+		file := fset.AddFile("", -1, len(src))
+		s := Scanner{Matcher: m}
+		if size == 0 {
+			s.SetSource(src)
+		} else {
+			s.SetBuffer(make([]byte, size), 64)
+			s.SetReader(iotest.OneByteReader(bytes.NewReader(src)))
+		}
+		s.SetLines(NewLines(file))
+		for s.Scan() && s.Token().ID != tEOF {
+			pos := s.Position(s.Token().Lo)
+			expect(pos).Equal(position(s.Token().Lo))
+			fpos := fset.Position(file.Pos(pos.Offset))
+			expect([]int{fpos.Line, fpos.Column}).Equal([]int{pos.Line, pos.Column})
+		}
+		expect(s.lines.Count()).Equal(5)
+		expect(s.lines.End()).Equal(len(src))
+		expect(file.LineCount()).Equal(5)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	expect := gspec.Expect(t.FailNow)
+	s := Scanner{Matcher: testMatcher()}
+	s.SetBuffer(make([]byte, 4), 64)
+	s.SetReader(iotest.OneByteReader(strings.NewReader("abcdef 123456")))
+	s.Scan()
+	s.Scan()
+	s.Scan()
+	expect(string(s.Slice(7, 13))).Equal("123456")
+	expect(string(s.Slice(9, 100))).Equal("3456")
+
+	s.SetSource([]byte("ab cd"))
+	s.Scan()
+	expect(string(s.Slice(1, 4))).Equal("b c")
+	expect(string(s.Slice(3, 100))).Equal("cd")
+}